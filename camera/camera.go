@@ -0,0 +1,118 @@
+// package camera provides a Camera that maps the pixels
+// of a canvas onto rays cast into a world
+package camera
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/world"
+)
+
+type Camera struct {
+	hsize, vsize          int
+	fieldOfView           float64
+	transform             matrices.Transformation
+	halfWidth, halfHeight float64
+	pixelSize             float64
+	maxDepth              int
+}
+
+// NewCamera returns a camera rendering hsize x vsize pixels
+// with the given field of view (in radians)
+//
+// The camera starts at the origin looking towards negative z,
+// and lets rays bounce world.DefaultMaxDepth times
+func NewCamera(hsize, vsize int, fieldOfView float64) *Camera {
+	c := &Camera{hsize: hsize, vsize: vsize, fieldOfView: fieldOfView, maxDepth: world.DefaultMaxDepth}
+	c.SetTransform(matrices.NewIdentityTransformation())
+
+	halfView := math.Tan(fieldOfView / 2)
+	aspect := float64(hsize) / float64(vsize)
+	if aspect >= 1 {
+		c.halfWidth = halfView
+		c.halfHeight = halfView / aspect
+	} else {
+		c.halfWidth = halfView * aspect
+		c.halfHeight = halfView
+	}
+	c.pixelSize = c.halfWidth * 2 / float64(hsize)
+	return c
+}
+
+// HSize returns the horizontal size of camera c in pixels
+func (c *Camera) HSize() int {
+	return c.hsize
+}
+
+// VSize returns the vertical size of camera c in pixels
+func (c *Camera) VSize() int {
+	return c.vsize
+}
+
+// FieldOfView returns the angle (in radians)
+// describing how much camera c can see
+func (c *Camera) FieldOfView() float64 {
+	return c.fieldOfView
+}
+
+// PixelSize returns the size of a single pixel of camera c
+// on the canvas one unit in front of it
+func (c *Camera) PixelSize() float64 {
+	return c.pixelSize
+}
+
+// Transform returns the view transformation of camera c
+func (c *Camera) Transform() matrices.Transformation {
+	return c.transform
+}
+
+// SetTransform sets the view transformation of camera c
+func (c *Camera) SetTransform(t matrices.Transformation) {
+	c.transform = t
+}
+
+// MaxDepth returns the number of times rays cast by camera c
+// are allowed to bounce off reflective surfaces
+func (c *Camera) MaxDepth() int {
+	return c.maxDepth
+}
+
+// SetMaxDepth sets the number of times rays cast by camera c
+// are allowed to bounce off reflective surfaces
+func (c *Camera) SetMaxDepth(depth int) {
+	c.maxDepth = depth
+}
+
+// RayForPixel returns the ray starting at camera c
+// and passing through the center of pixel (x,y)
+func (c *Camera) RayForPixel(x, y int) rays.Ray {
+	// offset from the edge of the canvas to the pixel's center
+	xOffset := (float64(x) + 0.5) * c.pixelSize
+	yOffset := (float64(y) + 0.5) * c.pixelSize
+
+	// the camera looks toward -z, so +x is to the left
+	worldX := c.halfWidth - xOffset
+	worldY := c.halfHeight - yOffset
+
+	inv := c.transform.Inverse()
+	pixel := matrices.Transform(tuples.NewPoint(worldX, worldY, -1), inv)
+	origin := matrices.Transform(tuples.NewPoint(0, 0, 0), inv)
+	direction, _ := pixel.Subtract(origin).Normalized()
+	return rays.NewRay(origin, direction)
+}
+
+// Render returns a canvas with the image of world w
+// as seen from camera c
+func (c *Camera) Render(w *world.World) canvas.Canvas {
+	image := canvas.NewCanvas(c.hsize, c.vsize)
+	for y := 0; y < c.vsize; y++ {
+		for x := 0; x < c.hsize; x++ {
+			image.SetPixelAt(x, y, w.ColorAt(c.RayForPixel(x, y), c.maxDepth))
+		}
+	}
+	return image
+}
@@ -0,0 +1,116 @@
+package camera
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+	"github.com/schapagain/raytracer/world"
+)
+
+// TestNewCamera checks if a new camera is initialized
+// with the given sizes and the identity transformation
+func TestNewCamera(t *testing.T) {
+	c := NewCamera(160, 120, math.Pi/2)
+	if c.HSize() != 160 || c.VSize() != 120 {
+		t.Fatalf("Expected camera size to be 160x120, but got %dx%d", c.HSize(), c.VSize())
+	}
+	if c.FieldOfView() != math.Pi/2 {
+		t.Fatalf("Expected field of view to be %f, but got %f", math.Pi/2, c.FieldOfView())
+	}
+	iden := matrices.NewIdentityTransformation()
+	if !c.Transform().Operator().IsEqualTo(iden.Operator()) {
+		t.Fatalf("Expected camera transform to be\n%s\nbut got\n%s", iden, c.Transform())
+	}
+	if c.MaxDepth() != world.DefaultMaxDepth {
+		t.Fatalf("Expected max depth to be %d, but got %d", world.DefaultMaxDepth, c.MaxDepth())
+	}
+	c.SetMaxDepth(2)
+	if c.MaxDepth() != 2 {
+		t.Fatalf("Expected max depth to be 2, but got %d", c.MaxDepth())
+	}
+}
+
+// TestPixelSize checks if the pixel size is computed
+// correctly for horizontal and vertical canvases
+func TestPixelSize(t *testing.T) {
+	testCases := []struct {
+		name         string
+		hsize, vsize int
+	}{
+		{"horizontal canvas", 200, 125},
+		{"vertical canvas", 125, 200},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := NewCamera(testCase.hsize, testCase.vsize, math.Pi/2)
+			if !utils.FloatEqual(c.PixelSize(), 0.01) {
+				t.Fatalf("Expected pixel size to be %f, but got %f", 0.01, c.PixelSize())
+			}
+		})
+	}
+}
+
+// TestRayForPixel checks if rays are cast through
+// the center of the requested pixels
+func TestRayForPixel(t *testing.T) {
+	k := math.Sqrt(2) / 2
+	// distance from the camera to the center of the corner pixel
+	cornerDist := math.Sqrt(200*200 + 100*100 + 201*201)
+	testCases := []struct {
+		name      string
+		x, y      int
+		transform matrices.Transformation
+		expRay    rays.Ray
+	}{
+		{
+			"center of the canvas", 100, 50, matrices.NewIdentityTransformation(),
+			rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, -1)),
+		},
+		{
+			"corner of the canvas", 0, 0, matrices.NewIdentityTransformation(),
+			rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(200/cornerDist, 100/cornerDist, -201/cornerDist)),
+		},
+		{
+			"transformed camera", 100, 50,
+			matrices.ViewTransform(tuples.NewPoint(0, 2, -5), tuples.NewPoint(k, 2, -5-k), tuples.NewVector(0, 1, 0)),
+			rays.NewRay(tuples.NewPoint(0, 2, -5), tuples.NewVector(k, 0, -k)),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := NewCamera(201, 101, math.Pi/2)
+			c.SetTransform(testCase.transform)
+			r := c.RayForPixel(testCase.x, testCase.y)
+			if !r.IsEqualTo(testCase.expRay) {
+				t.Fatalf("Expected ray for pixel (%d,%d) to be %s, but got %s", testCase.x, testCase.y, testCase.expRay, r)
+			}
+		})
+	}
+}
+
+// TestRender renders the default world and checks
+// if the center pixel has the expected color
+func TestRender(t *testing.T) {
+	w := world.DefaultWorld()
+	c := NewCamera(11, 11, math.Pi/2)
+	c.SetTransform(matrices.ViewTransform(tuples.NewPoint(0, 0, -5), tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0)))
+	image := c.Render(w)
+	if image.Width() != 11 || image.Height() != 11 {
+		t.Fatalf("Expected rendered image to be 11x11, but got %dx%d", image.Width(), image.Height())
+	}
+	// the center pixel sees the front of the outer sphere at (0,0,-1)
+	k := 0.1 + 0.7*9/math.Sqrt(281)
+	expColor := canvas.Color{R: 0.8 * k, G: k, B: 0.6 * k}
+	color, err := image.PixelAt(5, 5)
+	if err != nil {
+		t.Fatalf("No error expected reading pixel (5,5), but got: %q", err)
+	}
+	if !color.IsEqualTo(expColor) {
+		t.Fatalf("Expected center pixel to be %s, but got %s", expColor, color)
+	}
+}
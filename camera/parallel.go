@@ -0,0 +1,63 @@
+package camera
+
+import (
+	"context"
+	"runtime"
+	"sync"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/world"
+)
+
+// RenderParallel returns a canvas with the image of world w
+// as seen from camera c, rendered by the given number of workers
+//
+// The image is split into scanlines that are handed out to the workers.
+// Each pixel is written by exactly one worker, so the result is identical
+// to the one produced by Render regardless of the worker count.
+// If workers is less than one, runtime.NumCPU() workers are used.
+//
+// Rendering stops early if ctx is cancelled, in which case the
+// partially rendered canvas is returned along with ctx.Err()
+func (c *Camera) RenderParallel(ctx context.Context, w *world.World, workers int) (canvas.Canvas, error) {
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+	image := canvas.NewCanvas(c.hsize, c.vsize)
+	scanlines := make(chan int)
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for y := range scanlines {
+				if ctx.Err() != nil {
+					continue
+				}
+				for x := 0; x < c.hsize; x++ {
+					image.SetPixelAt(x, y, w.ColorAt(c.RayForPixel(x, y), c.maxDepth))
+				}
+			}
+		}()
+	}
+
+	var err error
+	for y := 0; y < c.vsize; y++ {
+		// select picks at random when both cases are ready,
+		// so a cancelled context has to be checked up front
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+		case scanlines <- y:
+		}
+		if err != nil {
+			break
+		}
+	}
+	close(scanlines)
+	wg.Wait()
+	return image, err
+}
@@ -0,0 +1,65 @@
+package camera
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/world"
+)
+
+// TestRenderParallelDeterminism renders the default world with
+// varying worker counts and checks if every image is identical
+// to the one rendered on a single goroutine
+func TestRenderParallelDeterminism(t *testing.T) {
+	w := world.DefaultWorld()
+	c := NewCamera(33, 21, math.Pi/2)
+	c.SetTransform(matrices.ViewTransform(tuples.NewPoint(0, 0, -5), tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0)))
+	expImage := c.Render(w)
+
+	for _, workers := range []int{1, 2, 3, 8, 64, 0} {
+		image, err := c.RenderParallel(context.Background(), w, workers)
+		if err != nil {
+			t.Fatalf("No error expected rendering with %d workers, but got: %q", workers, err)
+		}
+		for y := 0; y < c.VSize(); y++ {
+			for x := 0; x < c.HSize(); x++ {
+				expColor, _ := expImage.PixelAt(x, y)
+				color, _ := image.PixelAt(x, y)
+				if color != expColor {
+					t.Fatalf("Expected pixel (%d,%d) rendered with %d workers to be %s, but got %s", x, y, workers, expColor, color)
+				}
+			}
+		}
+	}
+}
+
+// TestRenderParallelCancellation checks if rendering
+// doesn't start when the context is already cancelled
+func TestRenderParallelCancellation(t *testing.T) {
+	w := world.DefaultWorld()
+	c := NewCamera(33, 21, math.Pi/2)
+	c.SetTransform(matrices.ViewTransform(tuples.NewPoint(0, 0, -5), tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0)))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for i := 0; i < 20; i++ {
+		image, err := c.RenderParallel(ctx, w, 4)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("Expected error to be %q, but got %q", context.Canceled, err)
+		}
+		if image == nil {
+			t.Fatalf("Expected partially rendered image to be returned")
+		}
+		for y := 0; y < c.VSize(); y++ {
+			for x := 0; x < c.HSize(); x++ {
+				if color, _ := image.PixelAt(x, y); color != (canvas.Color{}) {
+					t.Fatalf("Expected pixel (%d,%d) not to be rendered, but got %s", x, y, color)
+				}
+			}
+		}
+	}
+}
@@ -1,10 +1,11 @@
 // package canvas provides Canvas interface implemented as
 // an array of pixels, and provides methods for drawing,
-// saving to a file (as a plain PPM), etc.
+// saving to a file (as a PPM or PNG), etc.
 package canvas
 
 import (
 	"fmt"
+	"image"
 	"math"
 	"strconv"
 	"strings"
@@ -23,6 +24,7 @@ type Canvas interface {
 	PixelAt(int, int) (Color, error)
 	SetPixelAt(int, int, Color) error
 	ToPPM() PPM
+	ToImage() image.Image
 }
 
 type canvas struct {
@@ -52,6 +54,9 @@ func (c *canvas) PixelAt(x, y int) (Color, error) {
 
 // SetPixelAt sets the color of the pixel at (x,y) on the canvas c
 //
+// Each pixel occupies its own slot in the buffer, so it is safe to call
+// SetPixelAt from multiple goroutines as long as they write distinct pixels
+//
 // It returns an error if location (x,y) is out of bounds
 func (c *canvas) SetPixelAt(x, y int, color Color) error {
 	if x >= c.width || y >= c.height {
@@ -103,6 +108,15 @@ func (c Color) String() string {
 	return fmt.Sprintf("(%.3f,%.3f,%.3f,%.3f)", c.R, c.G, c.B, c.A)
 }
 
+// IsEqualTo reports whether c1 and c2 are equal
+// by performing channel-wise float comparison
+func (c1 Color) IsEqualTo(c2 Color) bool {
+	return utils.FloatEqual(c1.R, c2.R) &&
+		utils.FloatEqual(c1.G, c2.G) &&
+		utils.FloatEqual(c1.B, c2.B) &&
+		utils.FloatEqual(c1.A, c2.A)
+}
+
 // Add add each component of c1 and c2 and
 // returns the resulting color
 func (c1 Color) Add(c2 Color) Color {
@@ -25,6 +25,28 @@ func TestColorStringRepr(t *testing.T) {
 	}
 }
 
+// TestColorEquality checks if colors with
+// approximately equal channels are deemed equal
+func TestColorEquality(t *testing.T) {
+	testCases := []struct {
+		name        string
+		c1, c2      Color
+		expectEqual bool
+	}{
+		{"identical colors", Color{0.5, 1, 0, 1}, Color{0.5, 1, 0, 1}, true},
+		{"approx. equal colors", Color{0.5, 1, 0, 1}, Color{0.5000000001, 0.9999999999, 0, 1}, true},
+		{"differing red", Color{0.5, 1, 0, 1}, Color{0.51, 1, 0, 1}, false},
+		{"differing alpha", Color{0.5, 1, 0, 1}, Color{0.5, 1, 0, 0}, false},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.c1.IsEqualTo(testCase.c2) != testCase.expectEqual {
+				t.Fatalf("Expected %s and %s to be equal: %t", testCase.c1, testCase.c2, testCase.expectEqual)
+			}
+		})
+	}
+}
+
 // TestColorAddition creates two colors
 // and checks if adding c1 and c2
 // returns the resulting color
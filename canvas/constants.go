@@ -1,5 +1,11 @@
 package canvas
 
 const PPMMagic string = "P3"
+const PPMBinaryMagic string = "P6"
 const MaxPPMLineLength = 70
 const MaxColorValue int = 255
+const MaxColorValue16 int = 65535
+
+// MaxPPMPixels is the largest width*height accepted when decoding a PPM,
+// so that corrupt headers can't trigger huge allocations
+const MaxPPMPixels int = 1 << 25
@@ -0,0 +1,159 @@
+package canvas
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/schapagain/raytracer/errors"
+)
+
+// DecodePPM reads a plain (P3) or binary (P6) PPM from r
+// and returns a canvas holding its pixel data
+//
+// Comments and arbitrary whitespace are allowed between header values,
+// and colors are normalized to the 0 -> 1 range using the max color value
+//
+// It returns a FormatError if the header is malformed, describes more than
+// MaxPPMPixels pixels, or the pixel data is truncated, and an
+// OutOfBoundsError if a channel exceeds the max color value
+func DecodePPM(r io.Reader) (Canvas, error) {
+	reader := bufio.NewReader(r)
+	magic, err := readPPMToken(reader)
+	if err != nil {
+		return nil, &errors.FormatError{Details: "Missing PPM magic number"}
+	}
+	if magic != PPMMagic && magic != PPMBinaryMagic {
+		return nil, &errors.FormatError{Details: fmt.Sprintf("Unsupported PPM magic number %q", magic)}
+	}
+	header := make([]int, 3)
+	for i, name := range []string{"width", "height", "max color"} {
+		header[i], err = readPPMInt(reader)
+		if err != nil {
+			return nil, &errors.FormatError{Details: fmt.Sprintf("Invalid PPM %s: %s", name, err)}
+		}
+	}
+	width, height, maxColor := header[0], header[1], header[2]
+	if width < 1 || height < 1 {
+		return nil, &errors.FormatError{Details: fmt.Sprintf("Invalid PPM image size %dx%d", width, height)}
+	}
+	// compare by division so that width*height can't overflow
+	if width > MaxPPMPixels/height {
+		return nil, &errors.FormatError{Details: fmt.Sprintf("PPM image size %dx%d exceeds %d pixels", width, height, MaxPPMPixels)}
+	}
+	if maxColor < 1 || maxColor > MaxColorValue16 {
+		return nil, &errors.FormatError{Details: fmt.Sprintf("Invalid PPM max color %d", maxColor)}
+	}
+
+	readSample := func() (int, error) {
+		return readPPMInt(reader)
+	}
+	if magic == PPMBinaryMagic {
+		readSample = func() (int, error) {
+			return readPPMBinarySample(reader, maxColor)
+		}
+	}
+
+	c := NewCanvas(width, height)
+	samples := make([]float64, 3)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			for i := range samples {
+				sample, err := readSample()
+				if err != nil {
+					return nil, &errors.FormatError{Details: fmt.Sprintf("Invalid PPM pixel data at (%d,%d): %s", x, y, err)}
+				}
+				if sample > maxColor {
+					return nil, &errors.OutOfBoundsError{Details: fmt.Sprintf("PPM value %d at (%d,%d) exceeds max color %d", sample, x, y, maxColor)}
+				}
+				samples[i] = float64(sample) / float64(maxColor)
+			}
+			c.SetPixelAt(x, y, Color{R: samples[0], G: samples[1], B: samples[2]})
+		}
+	}
+	return c, nil
+}
+
+// LoadPPM reads the PPM file at filePath and
+// returns a canvas holding its pixel data
+func LoadPPM(filePath string) (Canvas, error) {
+	fi, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer fi.Close()
+	return DecodePPM(fi)
+}
+
+// isPPMWhitespace reports whether b separates values in a PPM
+func isPPMWhitespace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\v' || b == '\f'
+}
+
+// readPPMToken returns the next whitespace separated token in reader,
+// skipping comments that run from '#' to the end of the line
+//
+// The single whitespace character ending the token is consumed
+func readPPMToken(reader *bufio.Reader) (string, error) {
+	token := []byte{}
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			if err == io.EOF && len(token) > 0 {
+				return string(token), nil
+			}
+			if err == io.EOF {
+				return "", io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+		switch {
+		case b == '#':
+			if _, err := reader.ReadBytes('\n'); err != nil && err != io.EOF {
+				return "", err
+			}
+			if len(token) > 0 {
+				return string(token), nil
+			}
+		case isPPMWhitespace(b):
+			if len(token) > 0 {
+				return string(token), nil
+			}
+		default:
+			token = append(token, b)
+		}
+	}
+}
+
+// readPPMInt returns the next token in reader as a non-negative integer
+func readPPMInt(reader *bufio.Reader) (int, error) {
+	token, err := readPPMToken(reader)
+	if err != nil {
+		return 0, err
+	}
+	val, err := strconv.Atoi(token)
+	if err != nil || val < 0 {
+		return 0, fmt.Errorf("%q is not a non-negative integer", token)
+	}
+	return val, nil
+}
+
+// readPPMBinarySample returns the next raw sample in reader,
+// which is one byte wide if maxColor is below 256, and
+// two big-endian bytes wide otherwise
+func readPPMBinarySample(reader *bufio.Reader, maxColor int) (int, error) {
+	hi, err := reader.ReadByte()
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	if err != nil || maxColor <= 255 {
+		return int(hi), err
+	}
+	lo, err := reader.ReadByte()
+	if err == io.EOF {
+		return 0, io.ErrUnexpectedEOF
+	}
+	return int(hi)<<8 | int(lo), err
+}
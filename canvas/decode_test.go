@@ -0,0 +1,190 @@
+package canvas
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/schapagain/raytracer/errors"
+)
+
+// TestDecodePPM decodes PPMs and checks if the canvas
+// has the expected size and normalized colors
+func TestDecodePPM(t *testing.T) {
+	testCases := []struct {
+		name      string
+		ppm       string
+		expWidth  int
+		expHeight int
+		expPixels map[[2]int]Color
+	}{
+		{
+			"plain ppm",
+			"P3\n2 2\n255\n255 0 0 0 255 0\n0 0 255 255 255 255\n",
+			2, 2,
+			map[[2]int]Color{{0, 0}: {R: 1}, {1, 0}: {G: 1}, {0, 1}: {B: 1}, {1, 1}: {R: 1, G: 1, B: 1}},
+		},
+		{
+			"comments and whitespace",
+			"P3 # magic\n# a full line comment\n  2\t1\r\n#size above\n 15  \n0 5 10\n\n\t15 15 15",
+			2, 1,
+			map[[2]int]Color{{0, 0}: {R: 0, G: 1.0 / 3, B: 2.0 / 3}, {1, 0}: {R: 1, G: 1, B: 1}},
+		},
+		{
+			"binary ppm",
+			"P6\n# comment\n2 1\n255\n\xff\x00\x7f\x00\x00\xff",
+			2, 1,
+			map[[2]int]Color{{0, 0}: {R: 1, B: 127.0 / 255}, {1, 0}: {B: 1}},
+		},
+		{
+			"16-bit binary ppm",
+			"P6 1 1 65535\n\xff\xff\x80\x00\x00\x01",
+			1, 1,
+			map[[2]int]Color{{0, 0}: {R: 1, G: 32768.0 / 65535, B: 1.0 / 65535}},
+		},
+		{
+			"binary ppm with whitespace bytes in data",
+			"P6\n1 1\n255\n\x20\x0a\x23",
+			1, 1,
+			map[[2]int]Color{{0, 0}: {R: 32.0 / 255, G: 10.0 / 255, B: 35.0 / 255}},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := DecodePPM(strings.NewReader(testCase.ppm))
+			if err != nil {
+				t.Fatalf("No error expected decoding PPM, but got: %q", err)
+			}
+			if c.Width() != testCase.expWidth || c.Height() != testCase.expHeight {
+				t.Fatalf("Expected canvas size to be %dx%d, but got %dx%d", testCase.expWidth, testCase.expHeight, c.Width(), c.Height())
+			}
+			for loc, expColor := range testCase.expPixels {
+				color, _ := c.PixelAt(loc[0], loc[1])
+				if !color.IsEqualTo(expColor) {
+					t.Fatalf("Expected pixel at %v to be %s, but got %s", loc, expColor, color)
+				}
+			}
+		})
+	}
+}
+
+// TestDecodePPMErrors decodes malformed PPMs and
+// checks if the expected error types are returned
+func TestDecodePPMErrors(t *testing.T) {
+	testCases := []struct {
+		name           string
+		ppm            string
+		expOutOfBounds bool
+	}{
+		{"empty input", "", false},
+		{"unknown magic", "P5\n1 1\n255\n0", false},
+		{"missing size", "P3\n", false},
+		{"non-numeric size", "P3\nten 1\n255\n", false},
+		{"zero width", "P3\n0 1\n255\n", false},
+		{"overflowing size", "P6\n4294967296 4294967296\n255\n\x00\x00\x00", false},
+		{"oversized image", "P6\n100000 100000\n255\n\x00\x00\x00", false},
+		{"just over the pixel limit", fmt.Sprintf("P3\n%d 2\n255\n0 0 0", MaxPPMPixels/2+1), false},
+		{"max color too large", "P3\n1 1\n65536\n0 0 0", false},
+		{"zero max color", "P3\n1 1\n0\n0 0 0", false},
+		{"truncated plain data", "P3\n2 1\n255\n0 0 0 1 1", false},
+		{"truncated binary data", "P6\n2 1\n255\n\x00\x00\x00\x01", false},
+		{"truncated 16-bit binary data", "P6\n1 1\n65535\n\x00\x00\x00\x00\x00", false},
+		{"negative value", "P3\n1 1\n255\n0 -1 0", false},
+		{"value above max color", "P3\n1 1\n15\n0 16 0", true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := DecodePPM(strings.NewReader(testCase.ppm))
+			if err == nil {
+				t.Fatalf("Expected error decoding %q, but got none", testCase.ppm)
+			}
+			_, isOutOfBounds := err.(*errors.OutOfBoundsError)
+			_, isFormat := err.(*errors.FormatError)
+			if testCase.expOutOfBounds && !isOutOfBounds {
+				t.Fatalf("Expected OutOfBoundsError, but got: %q", err)
+			}
+			if !testCase.expOutOfBounds && !isFormat {
+				t.Fatalf("Expected FormatError, but got: %q", err)
+			}
+		})
+	}
+}
+
+// TestPPMRoundTrip encodes canvases with every supported
+// format and checks if decoding returns the same pixels
+func TestPPMRoundTrip(t *testing.T) {
+	c := NewCanvas(7, 4)
+	for y := 0; y < c.Height(); y++ {
+		for x := 0; x < c.Width(); x++ {
+			c.SetPixelAt(x, y, Color{R: float64(x) / 6, G: float64(y) / 3, B: float64(x*y) / 18})
+		}
+	}
+	testCases := []struct {
+		name string
+		opts PPMOptions
+	}{
+		{"plain", PPMOptions{}},
+		{"binary", PPMOptions{Binary: true}},
+		{"16-bit plain", PPMOptions{MaxColor: MaxColorValue16}},
+		{"16-bit binary", PPMOptions{Binary: true, MaxColor: MaxColorValue16}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			maxColor := testCase.opts.MaxColor
+			if maxColor == 0 {
+				maxColor = MaxColorValue
+			}
+			buf := bytes.Buffer{}
+			EncodePPM(&buf, c, testCase.opts)
+			decoded, err := DecodePPM(&buf)
+			if err != nil {
+				t.Fatalf("No error expected decoding PPM, but got: %q", err)
+			}
+			for y := 0; y < c.Height(); y++ {
+				for x := 0; x < c.Width(); x++ {
+					color, _ := c.PixelAt(x, y)
+					r, g, b := ppmSample(color, maxColor)
+					expColor := Color{R: float64(r) / float64(maxColor), G: float64(g) / float64(maxColor), B: float64(b) / float64(maxColor)}
+					decodedColor, _ := decoded.PixelAt(x, y)
+					if !decodedColor.IsEqualTo(expColor) {
+						t.Fatalf("Expected pixel (%d,%d) to be %s, but got %s", x, y, expColor, decodedColor)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("ToPPM output", func(t *testing.T) {
+		decoded, err := DecodePPM(strings.NewReader(c.ToPPM().String()))
+		if err != nil {
+			t.Fatalf("No error expected decoding PPM, but got: %q", err)
+		}
+		if decoded.Width() != c.Width() || decoded.Height() != c.Height() {
+			t.Fatalf("Expected canvas size to be %dx%d, but got %dx%d", c.Width(), c.Height(), decoded.Width(), decoded.Height())
+		}
+	})
+}
+
+// TestLoadPPM saves a canvas to a file and
+// checks if it can be loaded back
+func TestLoadPPM(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "image.ppm")
+	c := NewCanvas(3, 2)
+	c.SetPixelAt(2, 1, Color{R: 1, G: 1, B: 1})
+	if err := SavePPM(c, filePath, PPMOptions{Binary: true}); err != nil {
+		t.Fatalf("No error expected saving PPM, but got: %q", err)
+	}
+	loaded, err := LoadPPM(filePath)
+	if err != nil {
+		t.Fatalf("No error expected loading PPM, but got: %q", err)
+	}
+	color, _ := loaded.PixelAt(2, 1)
+	if !color.IsEqualTo(Color{R: 1, G: 1, B: 1}) {
+		t.Fatalf("Expected loaded pixel to be white, but got %s", color)
+	}
+	if _, err := LoadPPM(filepath.Join(t.TempDir(), "missing.ppm")); err == nil {
+		t.Fatalf("Expected error loading missing file, but got none")
+	}
+}
@@ -0,0 +1,112 @@
+package canvas
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/schapagain/raytracer/errors"
+)
+
+type PPMOptions struct {
+	// Binary selects the binary (P6) format instead of plain (P3) text
+	Binary bool
+	// MaxColor is the maximum channel value written to the PPM.
+	// It defaults to MaxColorValue, and can be up to MaxColorValue16
+	MaxColor int
+}
+
+// EncodePPM writes the pixel data of canvas c to w as a PPM
+//
+// Pixels are streamed to w as they are encoded, without building
+// the whole image in memory. In the binary format, channels take
+// one byte each if opts.MaxColor is below 256, and two big-endian bytes otherwise
+//
+// It returns an error if opts.MaxColor is out of range, or if writing to w fails
+func EncodePPM(w io.Writer, c Canvas, opts PPMOptions) error {
+	maxColor := opts.MaxColor
+	if maxColor == 0 {
+		maxColor = MaxColorValue
+	}
+	if maxColor < 1 || maxColor > MaxColorValue16 {
+		return &errors.OutOfBoundsError{Details: fmt.Sprintf("Invalid PPM max color %d", maxColor)}
+	}
+	magic := PPMMagic
+	if opts.Binary {
+		magic = PPMBinaryMagic
+	}
+
+	writer := bufio.NewWriter(w)
+	fmt.Fprintf(writer, "%s\n%d %d\n%d\n", magic, c.Width(), c.Height(), maxColor)
+	if opts.Binary {
+		encodeBinaryPixels(writer, c, maxColor)
+	} else {
+		encodePlainPixels(writer, c, maxColor)
+	}
+	return writer.Flush()
+}
+
+// SavePPM saves the pixel data of canvas c as a PPM to the given filePath
+func SavePPM(c Canvas, filePath string, opts PPMOptions) error {
+	fo, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if err = EncodePPM(fo, c, opts); err != nil {
+		fo.Close()
+		return err
+	}
+	return fo.Close()
+}
+
+// ppmSample returns the R,G,B channels of color scaled to maxColor,
+// and clipped to fit in the 0 -> maxColor range
+func ppmSample(color Color, maxColor int) (int, int, int) {
+	color = color.Scale(float64(maxColor))
+	clipColor(&color, 0, maxColor)
+	return int(color.R), int(color.G), int(color.B)
+}
+
+// encodePlainPixels writes the pixels of c as whitespace separated
+// decimal values, with as many pixels per line as fit in MaxPPMLineLength
+func encodePlainPixels(writer *bufio.Writer, c Canvas, maxColor int) {
+	maxPixelCharLength := len(strconv.Itoa(maxColor))*3 + 3
+	numPixelsInPPMLine := MaxPPMLineLength / maxPixelCharLength
+	line := make([]byte, 0, MaxPPMLineLength+1)
+	numPixels := c.Width() * c.Height()
+	for idx := 0; idx < numPixels; idx++ {
+		color, _ := c.PixelAt(idx%c.Width(), idx/c.Width())
+		r, g, b := ppmSample(color, maxColor)
+		if idx%numPixelsInPPMLine != 0 {
+			line = append(line, ' ')
+		}
+		line = strconv.AppendInt(line, int64(r), 10)
+		line = append(line, ' ')
+		line = strconv.AppendInt(line, int64(g), 10)
+		line = append(line, ' ')
+		line = strconv.AppendInt(line, int64(b), 10)
+		if (idx+1)%numPixelsInPPMLine == 0 || idx == numPixels-1 {
+			line = append(line, '\n')
+			writer.Write(line)
+			line = line[:0]
+		}
+	}
+}
+
+// encodeBinaryPixels writes the pixels of c as raw bytes, row by row
+func encodeBinaryPixels(writer *bufio.Writer, c Canvas, maxColor int) {
+	for y := 0; y < c.Height(); y++ {
+		for x := 0; x < c.Width(); x++ {
+			color, _ := c.PixelAt(x, y)
+			r, g, b := ppmSample(color, maxColor)
+			for _, sample := range []int{r, g, b} {
+				if maxColor > 255 {
+					writer.WriteByte(byte(sample >> 8))
+				}
+				writer.WriteByte(byte(sample))
+			}
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package canvas
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var errWriteFailed = errors.New("write failed")
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errWriteFailed
+}
+
+func newTestCanvas() Canvas {
+	c := NewCanvas(2, 3)
+	c.SetPixelAt(0, 1, Color{1, 0.5, 0, 1})
+	c.SetPixelAt(1, 2, Color{0, 0, 0.5, 1})
+	c.SetPixelAt(1, 0, Color{1.5, -0.5, 1, 1})
+	return c
+}
+
+// TestEncodePlainPPM encodes canvases as plain PPMs and checks
+// if the streamed output is as expected
+func TestEncodePlainPPM(t *testing.T) {
+	testCases := []struct {
+		name   string
+		opts   PPMOptions
+		expPPM string
+	}{
+		{
+			"default max color",
+			PPMOptions{},
+			"P3\n2 3\n255\n0 0 0 255 0 255 255 127 0 0 0 0 0 0 0\n0 0 127\n",
+		},
+		{
+			"16-bit max color",
+			PPMOptions{MaxColor: MaxColorValue16},
+			"P3\n2 3\n65535\n0 0 0 65535 0 65535 65535 32767 0\n0 0 0 0 0 0 0 0 32767\n",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			err := EncodePPM(&buf, newTestCanvas(), testCase.opts)
+			if err != nil {
+				t.Fatalf("No error expected encoding PPM, but got: %q", err)
+			}
+			if buf.String() != testCase.expPPM {
+				t.Fatalf("Expected ppm to be:\n%q\nGot:\n%q\n", testCase.expPPM, buf.String())
+			}
+		})
+	}
+
+	t.Run("matches ToPPM", func(t *testing.T) {
+		c := NewCanvas(10, 2)
+		for x := 0; x < 10; x++ {
+			c.SetPixelAt(x, 1, Color{1, 0.8, 0.6, 1})
+		}
+		buf := bytes.Buffer{}
+		EncodePPM(&buf, c, PPMOptions{})
+		expPPM := c.ToPPM().String() + "\n"
+		if buf.String() != expPPM {
+			t.Fatalf("Expected ppm to be:\n%q\nGot:\n%q\n", expPPM, buf.String())
+		}
+	})
+}
+
+// TestEncodeBinaryPPM encodes canvases as binary PPMs and checks
+// if the streamed output is as expected
+func TestEncodeBinaryPPM(t *testing.T) {
+	testCases := []struct {
+		name   string
+		opts   PPMOptions
+		expPPM []byte
+	}{
+		{
+			"default max color",
+			PPMOptions{Binary: true},
+			append([]byte("P6\n2 3\n255\n"),
+				0, 0, 0, 255, 0, 255,
+				255, 127, 0, 0, 0, 0,
+				0, 0, 0, 0, 0, 127),
+		},
+		{
+			"16-bit max color",
+			PPMOptions{Binary: true, MaxColor: MaxColorValue16},
+			append([]byte("P6\n2 3\n65535\n"),
+				0, 0, 0, 0, 0, 0, 255, 255, 0, 0, 255, 255,
+				255, 255, 127, 255, 0, 0, 0, 0, 0, 0, 0, 0,
+				0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 127, 255),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			err := EncodePPM(&buf, newTestCanvas(), testCase.opts)
+			if err != nil {
+				t.Fatalf("No error expected encoding PPM, but got: %q", err)
+			}
+			if !bytes.Equal(buf.Bytes(), testCase.expPPM) {
+				t.Fatalf("Expected ppm to be:\n%v\nGot:\n%v\n", testCase.expPPM, buf.Bytes())
+			}
+		})
+	}
+}
+
+// TestEncodePPMErrors checks if invalid options and
+// failing writers are reported as errors
+func TestEncodePPMErrors(t *testing.T) {
+	t.Run("max color too large", func(t *testing.T) {
+		err := EncodePPM(&bytes.Buffer{}, newTestCanvas(), PPMOptions{MaxColor: MaxColorValue16 + 1})
+		if err == nil {
+			t.Fatalf("Expected error encoding with max color %d, but got none", MaxColorValue16+1)
+		}
+	})
+	t.Run("negative max color", func(t *testing.T) {
+		err := EncodePPM(&bytes.Buffer{}, newTestCanvas(), PPMOptions{MaxColor: -1})
+		if err == nil {
+			t.Fatalf("Expected error encoding with max color -1, but got none")
+		}
+	})
+	t.Run("failing writer", func(t *testing.T) {
+		err := EncodePPM(failingWriter{}, newTestCanvas(), PPMOptions{})
+		if !errors.Is(err, errWriteFailed) {
+			t.Fatalf("Expected error %q, but got %q", errWriteFailed, err)
+		}
+	})
+}
+
+// TestSavePPM saves canvases to files and checks if the
+// file contents match the encoded PPM, and that
+// errors creating files are returned
+func TestSavePPM(t *testing.T) {
+	dir := t.TempDir()
+	t.Run("save to file", func(t *testing.T) {
+		filePath := filepath.Join(dir, "image.ppm")
+		opts := PPMOptions{Binary: true}
+		if err := SavePPM(newTestCanvas(), filePath, opts); err != nil {
+			t.Fatalf("No error expected saving PPM, but got: %q", err)
+		}
+		buf := bytes.Buffer{}
+		EncodePPM(&buf, newTestCanvas(), opts)
+		data, _ := os.ReadFile(filePath)
+		if !bytes.Equal(data, buf.Bytes()) {
+			t.Fatalf("Expected saved file to contain:\n%v\nGot:\n%v\n", buf.Bytes(), data)
+		}
+	})
+	t.Run("invalid path", func(t *testing.T) {
+		filePath := filepath.Join(dir, "missing", "image.ppm")
+		if err := SavePPM(newTestCanvas(), filePath, PPMOptions{}); err == nil {
+			t.Fatalf("Expected error saving PPM to %s, but got none", filePath)
+		}
+		if err := newTestCanvas().ToPPM().Save(filePath); err == nil {
+			t.Fatalf("Expected error saving PPM to %s, but got none", filePath)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package canvas
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"os"
+)
+
+type canvasImage struct {
+	c Canvas
+}
+
+// ColorModel returns the color model of the image,
+// which is always opaque 8-bit RGBA
+func (img *canvasImage) ColorModel() color.Model {
+	return color.RGBAModel
+}
+
+// Bounds returns the domain of the image, spanning
+// from (0,0) to the width and height of the canvas
+func (img *canvasImage) Bounds() image.Rectangle {
+	return image.Rect(0, 0, img.c.Width(), img.c.Height())
+}
+
+// At returns the color of pixel at (x,y)
+//
+// Channels are scaled and clipped the same way as in ToPPM,
+// and the alpha channel is ignored. Pixels outside the bounds are transparent
+func (img *canvasImage) At(x, y int) color.Color {
+	if x < 0 || y < 0 {
+		return color.RGBA{}
+	}
+	pixel, err := img.c.PixelAt(x, y)
+	if err != nil {
+		return color.RGBA{}
+	}
+	r, g, b := ppmSample(pixel, MaxColorValue)
+	return color.RGBA{R: uint8(r), G: uint8(g), B: uint8(b), A: 255}
+}
+
+// ToImage returns a view of canvas c as an image.Image
+//
+// The view reads pixels from c directly, so later changes
+// to c are reflected in the image
+func (c *canvas) ToImage() image.Image {
+	return &canvasImage{c}
+}
+
+// EncodePNG writes the pixel data of canvas c to w as a PNG
+func EncodePNG(w io.Writer, c Canvas) error {
+	return png.Encode(w, c.ToImage())
+}
+
+// SavePNG saves the pixel data of canvas c as a PNG to the given filePath
+func SavePNG(c Canvas, filePath string) error {
+	fo, err := os.Create(filePath)
+	if err != nil {
+		return err
+	}
+	if err = EncodePNG(fo, c); err != nil {
+		fo.Close()
+		return err
+	}
+	return fo.Close()
+}
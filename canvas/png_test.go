@@ -0,0 +1,90 @@
+package canvas
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestToImage checks if a canvas viewed as an image
+// has the expected bounds and clipped colors
+func TestToImage(t *testing.T) {
+	c := NewCanvas(2, 3)
+	c.SetPixelAt(0, 1, Color{1, 0.5, 0, 0})
+	c.SetPixelAt(1, 2, Color{1.5, -0.5, 0.5, 1})
+	img := c.ToImage()
+
+	expBounds := image.Rect(0, 0, 2, 3)
+	if img.Bounds() != expBounds {
+		t.Fatalf("Expected image bounds to be %v, but got %v", expBounds, img.Bounds())
+	}
+	testCases := []struct {
+		name     string
+		x, y     int
+		expColor color.RGBA
+	}{
+		{"unset pixel", 0, 0, color.RGBA{0, 0, 0, 255}},
+		{"same rounding as ppm", 0, 1, color.RGBA{255, 127, 0, 255}},
+		{"clipped channels", 1, 2, color.RGBA{255, 0, 127, 255}},
+		{"out of bounds", 2, 3, color.RGBA{}},
+		{"negative location", -1, 0, color.RGBA{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pixel := img.At(testCase.x, testCase.y)
+			if pixel != testCase.expColor {
+				t.Fatalf("Expected pixel (%d,%d) to be %v, but got %v", testCase.x, testCase.y, testCase.expColor, pixel)
+			}
+		})
+	}
+}
+
+// TestEncodePNG encodes a canvas as a PNG and checks
+// if decoding it returns the same pixels
+func TestEncodePNG(t *testing.T) {
+	c := NewCanvas(4, 2)
+	c.SetPixelAt(3, 1, Color{R: 0.2, G: 0.4, B: 1})
+	buf := bytes.Buffer{}
+	if err := EncodePNG(&buf, c); err != nil {
+		t.Fatalf("No error expected encoding PNG, but got: %q", err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("No error expected decoding PNG, but got: %q", err)
+	}
+	for y := 0; y < c.Height(); y++ {
+		for x := 0; x < c.Width(); x++ {
+			expR, expG, expB, expA := c.ToImage().At(x, y).RGBA()
+			r, g, b, a := img.At(x, y).RGBA()
+			if r != expR || g != expG || b != expB || a != expA {
+				t.Fatalf("Expected pixel (%d,%d) to be %v, but got %v", x, y, c.ToImage().At(x, y), img.At(x, y))
+			}
+		}
+	}
+}
+
+// TestSavePNG saves a canvas to a file and checks if it is
+// a valid PNG, and that errors creating files are returned
+func TestSavePNG(t *testing.T) {
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "image.png")
+	if err := SavePNG(NewCanvas(3, 5), filePath); err != nil {
+		t.Fatalf("No error expected saving PNG, but got: %q", err)
+	}
+	fi, _ := os.Open(filePath)
+	defer fi.Close()
+	config, err := png.DecodeConfig(fi)
+	if err != nil {
+		t.Fatalf("No error expected reading saved PNG, but got: %q", err)
+	}
+	if config.Width != 3 || config.Height != 5 {
+		t.Fatalf("Expected saved PNG to be 3x5, but got %dx%d", config.Width, config.Height)
+	}
+	if err := SavePNG(NewCanvas(3, 5), filepath.Join(dir, "missing", "image.png")); err == nil {
+		t.Fatalf("Expected error saving PNG to a missing directory, but got none")
+	}
+}
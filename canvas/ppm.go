@@ -42,10 +42,12 @@ func (p *ppm) Magic() string {
 }
 
 // Save saves the PPM to the given filePath
+//
+// It returns an error if the file cannot be created or written to
 func (p *ppm) Save(filePath string) error {
 	fo, err := os.Create(filePath)
 	if err != nil {
-		panic(err)
+		return err
 	}
 	writer := bufio.NewWriter(fo)
 	for _, headerLine := range p.header {
@@ -54,8 +56,11 @@ func (p *ppm) Save(filePath string) error {
 	for _, dataLine := range *p.dataLines {
 		writer.WriteString(dataLine + "\n")
 	}
-	writer.Flush()
-	return nil
+	if err = writer.Flush(); err != nil {
+		fo.Close()
+		return err
+	}
+	return fo.Close()
 }
 
 // ImageSize returns the dimensions of the PPM
@@ -32,4 +32,12 @@ type OutOfBoundsError struct {
 
 func (e *OutOfBoundsError) Error() string {
 	return fmt.Sprintf("OutofBoundsError: %s", e.Details)
-}
\ No newline at end of file
+}
+
+type FormatError struct {
+	Details string
+}
+
+func (e *FormatError) Error() string {
+	return fmt.Sprintf("FormatError: %s", e.Details)
+}
@@ -0,0 +1,68 @@
+// package lights provides light sources, and the Phong
+// reflection model used to shade points lit by them
+package lights
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type PointLight struct {
+	Position  tuples.Point
+	Intensity canvas.Color
+}
+
+// NewPointLight returns a light source with no size
+// that radiates light of the given intensity from position
+func NewPointLight(position tuples.Point, intensity canvas.Color) PointLight {
+	return PointLight{Position: position, Intensity: intensity}
+}
+
+// String returns the string representation of l
+func (l PointLight) String() string {
+	return fmt.Sprintf("light@%s%s", l.Position, l.Intensity)
+}
+
+// Lighting returns the color of point on the surface of object,
+// made of material, as lit by light and seen from the direction of eyev
+//
+// If material has a pattern, it is applied relative to object.
+// The color is the sum of the ambient, diffuse and specular
+// contributions of the Phong reflection model. Points inShadow
+// only receive the ambient contribution.
+// Both eyev and normalv are expected to be normalized
+func Lighting(material materials.Material, object shapes.Shape, light PointLight, point tuples.Point, eyev, normalv tuples.Vector, inShadow bool) canvas.Color {
+	black := canvas.Color{}
+	color := material.Color
+	if material.Pattern != nil {
+		color = shapes.PatternAt(material.Pattern, object, point)
+	}
+	effectiveColor := color.Multiply(light.Intensity)
+	lightv, _ := light.Position.Subtract(point).Normalized()
+	ambient := effectiveColor.Scale(material.Ambient)
+	if inShadow {
+		return ambient
+	}
+
+	// a negative cosine means the light is on the other side of the surface
+	lightDotNormal := lightv.Dot(normalv)
+	if lightDotNormal < 0 {
+		return ambient
+	}
+	diffuse := effectiveColor.Scale(material.Diffuse * lightDotNormal)
+
+	// a negative cosine means the light reflects away from the eye
+	specular := black
+	reflectv := lightv.Negated().Reflect(normalv)
+	reflectDotEye := reflectv.Dot(eyev)
+	if reflectDotEye > 0 {
+		factor := math.Pow(reflectDotEye, material.Shininess)
+		specular = light.Intensity.Scale(material.Specular * factor)
+	}
+	return ambient.Add(diffuse).Add(specular)
+}
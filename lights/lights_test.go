@@ -0,0 +1,115 @@
+package lights
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/patterns"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestNewPointLight checks if a point light
+// has its position and intensity set
+func TestNewPointLight(t *testing.T) {
+	position := tuples.NewPoint(0, 0, 0)
+	intensity := canvas.Color{R: 1, G: 1, B: 1}
+	light := NewPointLight(position, intensity)
+	if !light.Position.IsEqualTo(position) {
+		t.Fatalf("Expected light position to be %s, but got %s", position, light.Position)
+	}
+	if !light.Intensity.IsEqualTo(intensity) {
+		t.Fatalf("Expected light intensity to be %s, but got %s", intensity, light.Intensity)
+	}
+}
+
+// TestLighting shades a point on the default material
+// under various eye and light positions and checks if
+// the resulting colors are computed correctly
+func TestLighting(t *testing.T) {
+	m := materials.NewMaterial()
+	position := tuples.NewPoint(0, 0, 0)
+	k := math.Sqrt(2) / 2
+	// diffuse contribution when the light is 45 degrees off the normal
+	offsetDiffuse := 0.9 * k
+	testCases := []struct {
+		name     string
+		eyev     tuples.Vector
+		normalv  tuples.Vector
+		light    PointLight
+		inShadow bool
+		expColor float64
+	}{
+		{
+			"eye between light and surface",
+			tuples.NewVector(0, 0, -1), tuples.NewVector(0, 0, -1),
+			NewPointLight(tuples.NewPoint(0, 0, -10), canvas.Color{R: 1, G: 1, B: 1}),
+			false, 1.9,
+		},
+		{
+			"eye offset 45 degrees",
+			tuples.NewVector(0, k, -k), tuples.NewVector(0, 0, -1),
+			NewPointLight(tuples.NewPoint(0, 0, -10), canvas.Color{R: 1, G: 1, B: 1}),
+			false, 1.0,
+		},
+		{
+			"light offset 45 degrees",
+			tuples.NewVector(0, 0, -1), tuples.NewVector(0, 0, -1),
+			NewPointLight(tuples.NewPoint(0, 10, -10), canvas.Color{R: 1, G: 1, B: 1}),
+			false, 0.1 + offsetDiffuse,
+		},
+		{
+			"eye in path of reflection",
+			tuples.NewVector(0, -k, -k), tuples.NewVector(0, 0, -1),
+			NewPointLight(tuples.NewPoint(0, 10, -10), canvas.Color{R: 1, G: 1, B: 1}),
+			false, 0.1 + offsetDiffuse + 0.9,
+		},
+		{
+			"light behind surface",
+			tuples.NewVector(0, 0, -1), tuples.NewVector(0, 0, -1),
+			NewPointLight(tuples.NewPoint(0, 0, 10), canvas.Color{R: 1, G: 1, B: 1}),
+			false, 0.1,
+		},
+		{
+			"surface in shadow",
+			tuples.NewVector(0, 0, -1), tuples.NewVector(0, 0, -1),
+			NewPointLight(tuples.NewPoint(0, 0, -10), canvas.Color{R: 1, G: 1, B: 1}),
+			true, 0.1,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			expColor := canvas.Color{R: testCase.expColor, G: testCase.expColor, B: testCase.expColor}
+			color := Lighting(m, shapes.NewSphere(), testCase.light, position, testCase.eyev, testCase.normalv, testCase.inShadow)
+			if !color.IsEqualTo(expColor) {
+				t.Fatalf("Expected lighting to be %s, but got %s", expColor, color)
+			}
+		})
+	}
+}
+
+// TestLightingPattern checks if the pattern of a material
+// replaces its color
+func TestLightingPattern(t *testing.T) {
+	white := canvas.Color{R: 1, G: 1, B: 1}
+	m := materials.NewMaterial()
+	m.Pattern = patterns.NewStripePattern(white, canvas.Color{})
+	m.Ambient = 1
+	m.Diffuse = 0
+	m.Specular = 0
+	eyev := tuples.NewVector(0, 0, -1)
+	normalv := tuples.NewVector(0, 0, -1)
+	light := NewPointLight(tuples.NewPoint(0, 0, -10), white)
+	object := shapes.NewSphere()
+
+	c1 := Lighting(m, object, light, tuples.NewPoint(0.9, 0, 0), eyev, normalv, false)
+	if !c1.IsEqualTo(white) {
+		t.Fatalf("Expected lighting to be %s, but got %s", white, c1)
+	}
+	c2 := Lighting(m, object, light, tuples.NewPoint(1.1, 0, 0), eyev, normalv, false)
+	if !c2.IsEqualTo(canvas.Color{}) {
+		t.Fatalf("Expected lighting to be %s, but got %s", canvas.Color{}, c2)
+	}
+}
@@ -0,0 +1,18 @@
+package materials
+
+const DefaultAmbient = 0.1
+const DefaultDiffuse = 0.9
+const DefaultSpecular = 0.9
+const DefaultShininess = 200.0
+const DefaultReflective = 0.0
+const DefaultTransparency = 0.0
+const DefaultRefractiveIndex = RefractiveIndexVacuum
+
+// Refractive indices of common materials
+const (
+	RefractiveIndexVacuum  = 1.0
+	RefractiveIndexAir     = 1.00029
+	RefractiveIndexWater   = 1.333
+	RefractiveIndexGlass   = 1.5
+	RefractiveIndexDiamond = 2.417
+)
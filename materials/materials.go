@@ -0,0 +1,73 @@
+// package materials provides the Material type describing
+// how the surface of a shape responds to light
+package materials
+
+import (
+	"fmt"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/patterns"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// Material describes the surface of a shape
+//
+// If Pattern is set, it colors the surface in place of Color.
+// Reflective ranges from 0 for matte surfaces to 1 for perfect mirrors,
+// and Transparency from 0 for opaque surfaces to 1 for clear ones.
+// RefractiveIndex describes how much light bends entering the material
+type Material struct {
+	Color           canvas.Color
+	Pattern         patterns.Pattern
+	Ambient         float64
+	Diffuse         float64
+	Specular        float64
+	Shininess       float64
+	Reflective      float64
+	Transparency    float64
+	RefractiveIndex float64
+}
+
+// NewMaterial returns a white material with
+// the default Phong reflection attributes
+func NewMaterial() Material {
+	return Material{
+		Color:           canvas.Color{R: 1, G: 1, B: 1},
+		Ambient:         DefaultAmbient,
+		Diffuse:         DefaultDiffuse,
+		Specular:        DefaultSpecular,
+		Shininess:       DefaultShininess,
+		Reflective:      DefaultReflective,
+		Transparency:    DefaultTransparency,
+		RefractiveIndex: DefaultRefractiveIndex,
+	}
+}
+
+// NewGlassMaterial returns a clear material
+// with the refractive index of glass
+func NewGlassMaterial() Material {
+	m := NewMaterial()
+	m.Transparency = 1
+	m.RefractiveIndex = RefractiveIndexGlass
+	return m
+}
+
+// String returns the string representation of m
+func (m Material) String() string {
+	return fmt.Sprintf("{color:%s ambient:%.3f diffuse:%.3f specular:%.3f shininess:%.3f reflective:%.3f transparency:%.3f refractive index:%.3f}",
+		m.Color, m.Ambient, m.Diffuse, m.Specular, m.Shininess, m.Reflective, m.Transparency, m.RefractiveIndex)
+}
+
+// IsEqualTo reports whether m1 and m2 have equal colors
+// and reflection attributes, and share the same pattern
+func (m1 Material) IsEqualTo(m2 Material) bool {
+	return m1.Color.IsEqualTo(m2.Color) &&
+		m1.Pattern == m2.Pattern &&
+		utils.FloatEqual(m1.Ambient, m2.Ambient) &&
+		utils.FloatEqual(m1.Diffuse, m2.Diffuse) &&
+		utils.FloatEqual(m1.Specular, m2.Specular) &&
+		utils.FloatEqual(m1.Shininess, m2.Shininess) &&
+		utils.FloatEqual(m1.Reflective, m2.Reflective) &&
+		utils.FloatEqual(m1.Transparency, m2.Transparency) &&
+		utils.FloatEqual(m1.RefractiveIndex, m2.RefractiveIndex)
+}
@@ -0,0 +1,69 @@
+package materials
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/patterns"
+)
+
+// TestNewMaterial checks if a new material is
+// initialized with the default attributes
+func TestNewMaterial(t *testing.T) {
+	m := NewMaterial()
+	expColor := canvas.Color{R: 1, G: 1, B: 1}
+	if !m.Color.IsEqualTo(expColor) {
+		t.Fatalf("Expected default color to be %s, but got %s", expColor, m.Color)
+	}
+	if m.Pattern != nil {
+		t.Fatalf("Expected default material to have no pattern")
+	}
+	testCases := []struct {
+		name   string
+		val    float64
+		expVal float64
+	}{
+		{"ambient", m.Ambient, 0.1},
+		{"diffuse", m.Diffuse, 0.9},
+		{"specular", m.Specular, 0.9},
+		{"shininess", m.Shininess, 200},
+		{"reflective", m.Reflective, 0},
+		{"transparency", m.Transparency, 0},
+		{"refractive index", m.RefractiveIndex, 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.val != testCase.expVal {
+				t.Fatalf("Expected default %s to be %f, but got %f", testCase.name, testCase.expVal, testCase.val)
+			}
+		})
+	}
+}
+
+// TestNewGlassMaterial checks if glass is clear
+// and bends light like glass
+func TestNewGlassMaterial(t *testing.T) {
+	m := NewGlassMaterial()
+	if m.Transparency != 1 || m.RefractiveIndex != RefractiveIndexGlass {
+		t.Fatalf("Expected glass to have transparency 1 and refractive index %f, but got %s", RefractiveIndexGlass, m)
+	}
+}
+
+// TestMaterialIsEqual checks if materials with equal
+// attributes are deemed equal
+func TestMaterialIsEqual(t *testing.T) {
+	m1 := NewMaterial()
+	m2 := NewMaterial()
+	if !m1.IsEqualTo(m2) {
+		t.Fatalf("Expected %s to equal %s", m1, m2)
+	}
+	m2.Ambient = 1
+	if m1.IsEqualTo(m2) {
+		t.Fatalf("Not expected %s to equal %s", m1, m2)
+	}
+	m2 = NewMaterial()
+	m2.Pattern = patterns.NewStripePattern(canvas.Color{R: 1, G: 1, B: 1}, canvas.Color{})
+	if m1.IsEqualTo(m2) {
+		t.Fatalf("Not expected materials with different patterns to be equal")
+	}
+}
@@ -0,0 +1,65 @@
+package matrices
+
+// TransformChain composes transformations in the order they are added,
+// e.g, NewTransformChain().RotateX(a).Scale(2, 2, 2).Translate(0, 1, 0)
+// rotates first, then scales, then translates
+//
+// Every method returns a new chain, so partial chains can be reused
+type TransformChain struct {
+	operator Mat4
+}
+
+// NewTransformChain returns an empty chain
+// that leaves points and vectors unchanged
+func NewTransformChain() TransformChain {
+	return TransformChain{NewIdentityMat4()}
+}
+
+// Then returns chain c followed by the given transformations, in order
+func (c TransformChain) Then(transformations ...Transformation) TransformChain {
+	for _, t := range transformations {
+		c.operator = t.Mat4().Multiply(c.operator)
+	}
+	return c
+}
+
+// Translate returns chain c followed by a translation
+// by the given x,y,z units
+func (c TransformChain) Translate(x, y, z float64) TransformChain {
+	return c.Then(NewTranslation(x, y, z))
+}
+
+// Scale returns chain c followed by a scaling
+// by the given x,y,z factors
+func (c TransformChain) Scale(x, y, z float64) TransformChain {
+	return c.Then(NewScaling(x, y, z))
+}
+
+// RotateX returns chain c followed by a rotation around the x-axis
+func (c TransformChain) RotateX(angle float64) TransformChain {
+	return c.Then(NewRotationX(angle))
+}
+
+// RotateY returns chain c followed by a rotation around the y-axis
+func (c TransformChain) RotateY(angle float64) TransformChain {
+	return c.Then(NewRotationY(angle))
+}
+
+// RotateZ returns chain c followed by a rotation around the z-axis
+func (c TransformChain) RotateZ(angle float64) TransformChain {
+	return c.Then(NewRotationZ(angle))
+}
+
+// Shear returns chain c followed by a shear transformation
+func (c TransformChain) Shear(xy, xz, yx, yz, zx, zy float64) TransformChain {
+	return c.Then(NewShear(xy, xz, yx, yz, zx, zy))
+}
+
+// Build returns a single transformation equivalent to applying
+// every transformation in chain c in order, with its inverse
+// and inverse-transpose computed once and cached
+//
+// It returns an error if the composed transformation is not invertible
+func (c TransformChain) Build() (Transformation, error) {
+	return NewTransformation(c.operator)
+}
@@ -0,0 +1,108 @@
+package matrices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestTransformChain builds chained transformations and checks
+// if they are applied in the order they were added
+func TestTransformChain(t *testing.T) {
+	testCases := []struct {
+		name    string
+		chain   TransformChain
+		srcPt   tuples.Point
+		expDest tuples.Point
+	}{
+		{"empty chain", NewTransformChain(), tuples.NewPoint(1, 0, 1), tuples.NewPoint(1, 0, 1)},
+		{"rotate, scale, translate", NewTransformChain().RotateX(math.Pi/2).Scale(5, 5, 5).Translate(10, 5, 7), tuples.NewPoint(1, 0, 1), tuples.NewPoint(15, 0, 7)},
+		{"translate, scale", NewTransformChain().Translate(1, 0, 0).Scale(2, 2, 2), tuples.NewPoint(1, 0, 0), tuples.NewPoint(4, 0, 0)},
+		{"scale, translate", NewTransformChain().Scale(2, 2, 2).Translate(1, 0, 0), tuples.NewPoint(1, 0, 0), tuples.NewPoint(3, 0, 0)},
+		{"rotations", NewTransformChain().RotateY(math.Pi / 2).RotateZ(math.Pi / 2), tuples.NewPoint(0, 0, 1), tuples.NewPoint(0, 1, 0)},
+		{"shear", NewTransformChain().Shear(1, 0, 0, 0, 0, 0), tuples.NewPoint(2, 3, 4), tuples.NewPoint(5, 3, 4)},
+		{"then", NewTransformChain().Then(NewScaling(2, 2, 2), NewTranslation(0, 1, 0)), tuples.NewPoint(1, 1, 1), tuples.NewPoint(2, 3, 2)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			transform, err := testCase.chain.Build()
+			if err != nil {
+				t.Fatalf("No error expected building chain, but got: %q", err)
+			}
+			dest := Transform(testCase.srcPt, transform)
+			if !dest.IsEqualTo(testCase.expDest) {
+				t.Fatalf("Expected\n%s\nto transform %s to %s, but got %s instead", transform, testCase.srcPt, testCase.expDest, dest)
+			}
+			back := Transform(dest, transform.Inverse())
+			if !back.IsEqualTo(testCase.srcPt) {
+				t.Fatalf("Expected inverse to transform %s back to %s, but got %s instead", dest, testCase.srcPt, back)
+			}
+		})
+	}
+}
+
+// TestTransformChainReuse checks if extending a chain
+// leaves the original chain unchanged
+func TestTransformChainReuse(t *testing.T) {
+	base := NewTransformChain().Scale(2, 2, 2)
+	extended := base.Translate(1, 0, 0)
+	baseTransform, _ := base.Build()
+	extendedTransform, _ := extended.Build()
+	p := tuples.NewPoint(1, 0, 0)
+	if dest := Transform(p, baseTransform); !dest.IsEqualTo(tuples.NewPoint(2, 0, 0)) {
+		t.Fatalf("Expected base chain to transform %s to %s, but got %s", p, tuples.NewPoint(2, 0, 0), dest)
+	}
+	if dest := Transform(p, extendedTransform); !dest.IsEqualTo(tuples.NewPoint(3, 0, 0)) {
+		t.Fatalf("Expected extended chain to transform %s to %s, but got %s", p, tuples.NewPoint(3, 0, 0), dest)
+	}
+}
+
+// TestTransformChainNotInvertible checks if building a chain
+// that cannot be undone returns an error
+func TestTransformChainNotInvertible(t *testing.T) {
+	_, err := NewTransformChain().Translate(1, 2, 3).Scale(1, 0, 1).Build()
+	if err != ErrMatrixNotInvertible {
+		t.Fatalf("Expected error %q, but got %q", ErrMatrixNotInvertible, err)
+	}
+	if NewScaling(0, 1, 1).IsInvertible() {
+		t.Fatalf("Not expected a scaling by zero to be invertible")
+	}
+	if !NewScaling(2, 1, 1).IsInvertible() {
+		t.Fatalf("Expected a non-zero scaling to be invertible")
+	}
+}
+
+// TestCachedInverse checks if inverses and inverse-transposes
+// are cached and consistent with each other
+func TestCachedInverse(t *testing.T) {
+	transform, _ := NewTransformChain().RotateZ(0.3).Scale(1, 4, 0.5).Translate(1, 2, 3).Build()
+	if transform.Inverse() != transform.Inverse() {
+		t.Fatalf("Expected inverse to be computed once and reused")
+	}
+	if transform.Inverse().Inverse() != transform {
+		t.Fatalf("Expected inverse of the inverse to be the original transformation")
+	}
+	expInv, _ := transform.Mat4().Inverse()
+	if !transform.Inverse().Mat4().IsEqualTo(expInv) {
+		t.Fatalf("Expected inverse to be\n%s\nbut got\n%s", expInv, transform.Inverse())
+	}
+	if !transform.InverseTransposed().Mat4().IsEqualTo(expInv.Transpose()) {
+		t.Fatalf("Expected inverse-transpose to be\n%s\nbut got\n%s", expInv.Transpose(), transform.InverseTransposed())
+	}
+	if !transform.Inverse().InverseTransposed().Mat4().IsEqualTo(transform.Mat4().Transpose()) {
+		t.Fatalf("Expected inverse-transpose of the inverse to be\n%s\nbut got\n%s", transform.Mat4().Transpose(), transform.Inverse().InverseTransposed())
+	}
+	transposed := transform.Transposed()
+	if !transposed.Inverse().Mat4().IsEqualTo(expInv.Transpose()) {
+		t.Fatalf("Expected inverse of the transpose to be\n%s\nbut got\n%s", expInv.Transpose(), transposed.Inverse())
+	}
+}
+
+// BenchmarkInverse fetches the inverse of a transformation
+func BenchmarkInverse(b *testing.B) {
+	transform, _ := NewTransformChain().RotateZ(0.3).Scale(1, 4, 0.5).Translate(1, 2, 3).Build()
+	for i := 0; i < b.N; i++ {
+		benchMat4 = transform.Inverse().Mat4()
+	}
+}
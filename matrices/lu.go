@@ -0,0 +1,228 @@
+package matrices
+
+import "math"
+
+// machineEpsilon is the difference between 1 and
+// the next representable float64
+const machineEpsilon = 0x1p-52
+
+// LUDecomposition holds the factorization PA = LU of a square matrix A,
+// where P is a row permutation, L is unit lower triangular
+// and U is upper triangular
+type LUDecomposition struct {
+	// lu stores L below the diagonal and U on and above it
+	lu       []float64
+	n        int
+	perm     []int
+	sign     float64
+	singular bool
+}
+
+// NewLUDecomposition returns the LU decomposition of matrix m
+// computed with partial pivoting
+//
+// m is deemed singular if any pivot is lost in rounding error, that is
+// no larger than n times the machine epsilon relative to the largest
+// absolute value in m, or is not finite. Matrices that are merely
+// ill-conditioned are not singular, and are reported by Cond instead
+//
+// It returns an error if m is not a square matrix
+func NewLUDecomposition(m Matrix) (*LUDecomposition, error) {
+	if m.Rows() != m.Cols() {
+		return nil, ErrDimensionMismatch
+	}
+	n := m.Rows()
+	dec := &LUDecomposition{
+		lu:   make([]float64, n*n),
+		n:    n,
+		perm: make([]int, n),
+		sign: 1,
+	}
+	scale := 0.0
+	for i := 0; i < n; i++ {
+		row, _ := m.GetRow(i)
+		copy(dec.lu[i*n:i*n+n], row)
+		for _, val := range row {
+			scale = math.Max(scale, math.Abs(val))
+		}
+		dec.perm[i] = i
+	}
+	tolerance := float64(n) * machineEpsilon * scale
+
+	lu := dec.lu
+	for k := 0; k < n; k++ {
+		// pick the row with the largest pivot candidate
+		pivotRow := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(lu[i*n+k]) > math.Abs(lu[pivotRow*n+k]) {
+				pivotRow = i
+			}
+		}
+		if pivotRow != k {
+			for j := 0; j < n; j++ {
+				lu[k*n+j], lu[pivotRow*n+j] = lu[pivotRow*n+j], lu[k*n+j]
+			}
+			dec.perm[k], dec.perm[pivotRow] = dec.perm[pivotRow], dec.perm[k]
+			dec.sign = -dec.sign
+		}
+		pivot := lu[k*n+k]
+		if math.Abs(pivot) <= tolerance || math.IsNaN(pivot) || math.IsInf(pivot, 0) {
+			dec.singular = true
+			continue
+		}
+		for i := k + 1; i < n; i++ {
+			factor := lu[i*n+k] / pivot
+			lu[i*n+k] = factor
+			for j := k + 1; j < n; j++ {
+				lu[i*n+j] -= factor * lu[k*n+j]
+			}
+		}
+	}
+	return dec, nil
+}
+
+// IsSingular reports whether the decomposed matrix is not invertible
+func (dec *LUDecomposition) IsSingular() bool {
+	return dec.singular
+}
+
+// Det returns the determinant of the decomposed matrix
+func (dec *LUDecomposition) Det() float64 {
+	det := dec.sign
+	for i := 0; i < dec.n; i++ {
+		det *= dec.lu[i*dec.n+i]
+	}
+	return det
+}
+
+// Solve returns x satisfying Ax = b,
+// where A is the decomposed matrix
+//
+// It returns an error if b doesn't have one value per row of A,
+// or if A is not invertible
+func (dec *LUDecomposition) Solve(b []float64) ([]float64, error) {
+	if len(b) != dec.n {
+		return nil, ErrDimensionMismatch
+	}
+	if dec.singular {
+		return nil, ErrMatrixNotInvertible
+	}
+	n, lu := dec.n, dec.lu
+	x := make([]float64, n)
+	// forward substitution with L on the permuted b
+	for i := 0; i < n; i++ {
+		sum := b[dec.perm[i]]
+		for j := 0; j < i; j++ {
+			sum -= lu[i*n+j] * x[j]
+		}
+		x[i] = sum
+	}
+	// back substitution with U
+	for i := n - 1; i >= 0; i-- {
+		sum := x[i]
+		for j := i + 1; j < n; j++ {
+			sum -= lu[i*n+j] * x[j]
+		}
+		x[i] = sum / lu[i*n+i]
+	}
+	return x, nil
+}
+
+// solveTransposed returns x satisfying (A^T)x = b,
+// where A is the decomposed matrix
+func (dec *LUDecomposition) solveTransposed(b []float64) ([]float64, error) {
+	if len(b) != dec.n {
+		return nil, ErrDimensionMismatch
+	}
+	if dec.singular {
+		return nil, ErrMatrixNotInvertible
+	}
+	n, lu := dec.n, dec.lu
+	// A^T = U^T L^T P, so solve with U^T, then L^T, then undo P
+	w := make([]float64, n)
+	for i := 0; i < n; i++ {
+		sum := b[i]
+		for j := 0; j < i; j++ {
+			sum -= lu[j*n+i] * w[j]
+		}
+		w[i] = sum / lu[i*n+i]
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := i + 1; j < n; j++ {
+			w[i] -= lu[j*n+i] * w[j]
+		}
+	}
+	x := make([]float64, n)
+	for i := 0; i < n; i++ {
+		x[dec.perm[i]] = w[i]
+	}
+	return x, nil
+}
+
+// Inverse returns the inverse of the decomposed matrix,
+// solving for one column of the identity at a time
+//
+// It returns an error if the decomposed matrix is not invertible
+func (dec *LUDecomposition) Inverse() (Matrix, error) {
+	if dec.singular {
+		return nil, ErrMatrixNotInvertible
+	}
+	invMat, _ := NewMatrix(dec.n, dec.n)
+	e := make([]float64, dec.n)
+	for j := 0; j < dec.n; j++ {
+		e[j] = 1
+		col, _ := dec.Solve(e)
+		e[j] = 0
+		for i, val := range col {
+			invMat.Set(i, j, val)
+		}
+	}
+	return invMat, nil
+}
+
+// InverseNorm1 returns an estimate of the 1-norm of the inverse
+// of the decomposed matrix, without computing the inverse
+//
+// It uses Hager's method, which needs a handful of solves
+// and is exact for most matrices
+//
+// It returns an error if the decomposed matrix is not invertible
+func (dec *LUDecomposition) InverseNorm1() (float64, error) {
+	if dec.singular {
+		return 0, ErrMatrixNotInvertible
+	}
+	n := dec.n
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = 1 / float64(n)
+	}
+	estimate := 0.0
+	for iter := 0; iter < 5; iter++ {
+		y, _ := dec.Solve(x)
+		estimate = 0
+		xi := make([]float64, n)
+		for i, val := range y {
+			estimate += math.Abs(val)
+			xi[i] = 1
+			if val < 0 {
+				xi[i] = -1
+			}
+		}
+		z, _ := dec.solveTransposed(xi)
+		maxIdx, zx := 0, 0.0
+		for i, val := range z {
+			zx += val * x[i]
+			if math.Abs(val) > math.Abs(z[maxIdx]) {
+				maxIdx = i
+			}
+		}
+		if math.Abs(z[maxIdx]) <= zx {
+			break
+		}
+		for i := range x {
+			x[i] = 0
+		}
+		x[maxIdx] = 1
+	}
+	return estimate, nil
+}
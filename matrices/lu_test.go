@@ -0,0 +1,221 @@
+package matrices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestLUDecomposition decomposes matrices and checks if
+// the permuted matrix equals the product of L and U
+func TestLUDecomposition(t *testing.T) {
+	testCases := []struct {
+		name string
+		matA [][]float64
+	}{
+		{"needs pivoting", [][]float64{{0, 1}, {1, 0}}},
+		{"3d matrix", [][]float64{{-0.444, 1.98, 343.89}, {0, 8.77, 1.034}, {-34, 34, -11.90}}},
+		{"4d non-zero matrix", testMat4Values},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matA, _ := NewMatrixFromSlice(testCase.matA)
+			dec, err := NewLUDecomposition(matA)
+			if err != nil {
+				t.Fatalf("No error expected decomposing\n%s\nbut received one: %q", matA, err)
+			}
+			n := matA.Rows()
+			l, _ := NewIdentityMatrix(n)
+			u, _ := NewMatrix(n, n)
+			pa, _ := NewMatrix(n, n)
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if j < i {
+						l.Set(i, j, dec.lu[i*n+j])
+					} else {
+						u.Set(i, j, dec.lu[i*n+j])
+					}
+					val, _ := matA.Get(dec.perm[i], j)
+					pa.Set(i, j, val)
+				}
+			}
+			prod, _ := l.Multiply(u)
+			if !prod.IsEqualTo(pa) {
+				t.Fatalf("Expected LU to be:\n%s\nbut, got:\n%s\n", pa, prod)
+			}
+		})
+	}
+
+	t.Run("rectangle matrix", func(t *testing.T) {
+		matA, _ := NewMatrixFromSlice([][]float64{{0, 0, 0.4}, {1.3, 0, 0}})
+		if _, err := NewLUDecomposition(matA); err == nil {
+			t.Fatalf("Expected error decomposing a rectangle matrix, but got none")
+		}
+	})
+}
+
+// TestInverseByPivotMagnitude checks if invertibility is decided by
+// the pivots of the decomposition, so that only pivots lost in rounding
+// error make a matrix singular, regardless of the scale of its entries
+func TestInverseByPivotMagnitude(t *testing.T) {
+	testCases := []struct {
+		name   string
+		matA   [][]float64
+		expErr bool
+	}{
+		{"small uniform scale", [][]float64{{1e-3, 0, 0, 0}, {0, 1e-3, 0, 0}, {0, 0, 1e-3, 0}, {0, 0, 0, 1e-3}}, false},
+		{"dependent rows", [][]float64{{1, 2, 3}, {2, 4, 6}, {1, 0, 1}}, true},
+		{"nearly dependent rows", [][]float64{{1, 2}, {1, 2 + 1e-9}}, false},
+		{"large translation", [][]float64{{1, 0, 0, 1e6}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}}, false},
+		{"large diagonal", [][]float64{{1e7, 0}, {0, 1}}, false},
+		{"non-finite entry", [][]float64{{math.Inf(1), 0}, {0, 1}}, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matA, _ := NewMatrixFromSlice(testCase.matA)
+			inv, err := matA.Inverse()
+			if testCase.expErr {
+				if err != ErrMatrixNotInvertible {
+					t.Fatalf("Expected error %q inverting\n%s\nbut got %q", ErrMatrixNotInvertible, matA, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error expected inverting\n%s\nbut received one: %q", matA, err)
+			}
+			if det, _ := matA.Det(); det == 0 {
+				t.Fatalf("Expected invertible\n%s\nto have a non-zero determinant", matA)
+			}
+			iden, _ := NewIdentityMatrix(matA.Rows())
+			prod, _ := matA.Multiply(inv)
+			if !prod.IsEqualTo(iden) {
+				t.Fatalf("Expected\n%s\ntimes its inverse to be the identity, but got\n%s", matA, prod)
+			}
+		})
+	}
+}
+
+// TestLargeMatrixInverse inverts a matrix too large for
+// cofactor expansion and checks the result
+func TestLargeMatrixInverse(t *testing.T) {
+	n := 16
+	mat, _ := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			mat.Set(i, j, math.Sin(float64(i*n+j)))
+		}
+		mat.Set(i, i, float64(n))
+	}
+	inv, err := mat.Inverse()
+	if err != nil {
+		t.Fatalf("No error expected inverting a %dx%d matrix, but received one: %q", n, n, err)
+	}
+	iden, _ := NewIdentityMatrix(n)
+	prod, _ := mat.Multiply(inv)
+	if !prod.IsEqualTo(iden) {
+		t.Fatalf("Expected matrix times its inverse to be the identity, but got\n%s", prod)
+	}
+	det, _ := mat.Det()
+	invDet, _ := inv.Det()
+	if !utils.FloatEqual(det*invDet, 1) {
+		t.Fatalf("Expected determinant of the inverse to be %g, but got %g", 1/det, invDet)
+	}
+}
+
+// TestSolve solves linear systems and checks if
+// the expected solutions and errors are returned
+func TestSolve(t *testing.T) {
+	testCases := []struct {
+		name   string
+		matA   [][]float64
+		b      []float64
+		expX   []float64
+		expErr bool
+	}{
+		{"identity", [][]float64{{1, 0}, {0, 1}}, []float64{3, -2}, []float64{3, -2}, false},
+		{"needs pivoting", [][]float64{{0, 2, 1}, {1, 1, 1}, {2, 1, 0}}, []float64{5, 4, 4}, []float64{1, 2, 1}, false},
+		{"4d system", testMat4Values, []float64{13.03, 29.2, 52.036, 62.88}, []float64{1, 2, 3, 4}, false},
+		{"large diagonal", [][]float64{{1e7, 0}, {0, 1}}, []float64{1e7, 2}, []float64{1, 2}, false},
+		{"length mismatch", [][]float64{{1, 0}, {0, 1}}, []float64{1, 2, 3}, nil, true},
+		{"singular matrix", [][]float64{{1, 2}, {2, 4}}, []float64{1, 2}, nil, true},
+		{"rectangle matrix", [][]float64{{1, 2, 3}, {2, 4, 5}}, []float64{1, 2}, nil, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matA, _ := NewMatrixFromSlice(testCase.matA)
+			x, err := matA.Solve(testCase.b)
+			if testCase.expErr {
+				if err == nil {
+					t.Fatalf("Expected error solving\n%s\nfor %v, but got none", matA, testCase.b)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error expected solving\n%s\nfor %v, but received one: %q", matA, testCase.b, err)
+			}
+			if !utils.FloatSlicesEqual(x, testCase.expX) {
+				t.Fatalf("Expected solution to be %v, but got %v", testCase.expX, x)
+			}
+		})
+	}
+}
+
+// TestCond checks if condition numbers are estimated
+// correctly for well and poorly conditioned matrices
+func TestCond(t *testing.T) {
+	testCases := []struct {
+		name    string
+		matA    [][]float64
+		expCond float64
+		expErr  bool
+	}{
+		{"identity", [][]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, 1, false},
+		{"scaled axis", [][]float64{{1, 0}, {0, 1e-3}}, 1e3, false},
+		{"2d matrix", [][]float64{{1, 2}, {3, 4}}, 21, false},
+		{"large diagonal", [][]float64{{1e7, 0}, {0, 1}}, 1e7, false},
+		{"singular matrix", [][]float64{{1, 2}, {2, 4}}, 0, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			matA, _ := NewMatrixFromSlice(testCase.matA)
+			cond, err := matA.Cond()
+			if testCase.expErr {
+				if err == nil {
+					t.Fatalf("Expected error estimating condition number of\n%s\nbut got none", matA)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error expected estimating condition number of\n%s\nbut received one: %q", matA, err)
+			}
+			if !utils.FloatEqual(cond, testCase.expCond) {
+				t.Fatalf("Expected condition number of\n%s\nto be %f, but got %f", matA, testCase.expCond, cond)
+			}
+		})
+	}
+
+	t.Run("matches exact inverse norm", func(t *testing.T) {
+		matA, _ := NewMatrixFromSlice(testMat4Values)
+		inv, _ := matA.Inverse()
+		expCond := matA.(*matrix).norm1() * inv.(*matrix).norm1()
+		cond, _ := matA.Cond()
+		if !utils.FloatEqual(cond, expCond) {
+			t.Fatalf("Expected condition number to be %f, but got %f", expCond, cond)
+		}
+	})
+}
+
+// BenchmarkLargeMatrixInverse inverts a 32x32 matrix
+func BenchmarkLargeMatrixInverse(b *testing.B) {
+	n := 32
+	mat, _ := NewMatrix(n, n)
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			mat.Set(i, j, math.Sin(float64(i*n+j)))
+		}
+	}
+	for i := 0; i < b.N; i++ {
+		benchMatrix, _ = mat.Inverse()
+	}
+}
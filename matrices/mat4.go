@@ -0,0 +1,187 @@
+package matrices
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// Mat4 is a 4x4 matrix stored by value in row-major order
+//
+// Unlike Matrix, none of its operations allocate,
+// which makes it suitable for transforming rays and tuples
+type Mat4 [16]float64
+
+// NewIdentityMat4 returns the 4x4 identity matrix
+func NewIdentityMat4() Mat4 {
+	return Mat4{
+		1, 0, 0, 0,
+		0, 1, 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
+	}
+}
+
+// NewMat4FromMatrix returns a Mat4 with the values of matrix m
+//
+// It returns an error if m is not a 4x4 matrix
+func NewMat4FromMatrix(m Matrix) (Mat4, error) {
+	if m.Rows() != 4 || m.Cols() != 4 {
+		return Mat4{}, ErrDimensionMismatch
+	}
+	mat := Mat4{}
+	for i := 0; i < 4; i++ {
+		row, _ := m.GetRow(i)
+		copy(mat[i*4:i*4+4], row)
+	}
+	return mat, nil
+}
+
+// Matrix returns a copy of m as a general Matrix
+func (m Mat4) Matrix() Matrix {
+	mat, _ := NewMatrix(4, 4)
+	for i, val := range m {
+		mat.Set(i/4, i%4, val)
+	}
+	return mat
+}
+
+// At returns the value of row i and column j of m
+func (m Mat4) At(i, j int) float64 {
+	return m[i*4+j]
+}
+
+// String returns the string representation of m
+func (m Mat4) String() string {
+	s := strings.Builder{}
+	for i, val := range m {
+		if i > 0 && i%4 == 0 {
+			s.WriteString("\n")
+		}
+		if i%4 != 0 {
+			s.WriteString(" ")
+		}
+		s.WriteString(fmt.Sprintf("%14.3f", val))
+	}
+	return s.String()
+}
+
+// IsEqualTo reports whether values in corresponding locations
+// of m1 and m2 are equal under float comparison
+func (m1 Mat4) IsEqualTo(m2 Mat4) bool {
+	for i := range m1 {
+		if !utils.FloatEqual(m1[i], m2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Multiply returns the result of multiplying m1 and m2
+func (m1 Mat4) Multiply(m2 Mat4) Mat4 {
+	prod := Mat4{}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			prod[i*4+j] = m1[i*4]*m2[j] +
+				m1[i*4+1]*m2[4+j] +
+				m1[i*4+2]*m2[8+j] +
+				m1[i*4+3]*m2[12+j]
+		}
+	}
+	return prod
+}
+
+// MulPoint returns the result of multiplying m with point p,
+// treating p as a column with a w component of one
+func (m Mat4) MulPoint(p tuples.Point) tuples.Point {
+	return tuples.NewPoint(
+		m[0]*p.X+m[1]*p.Y+m[2]*p.Z+m[3],
+		m[4]*p.X+m[5]*p.Y+m[6]*p.Z+m[7],
+		m[8]*p.X+m[9]*p.Y+m[10]*p.Z+m[11],
+	)
+}
+
+// MulVector returns the result of multiplying m with vector v,
+// treating v as a column with a w component of zero
+func (m Mat4) MulVector(v tuples.Vector) tuples.Vector {
+	return tuples.NewVector(
+		m[0]*v.X+m[1]*v.Y+m[2]*v.Z,
+		m[4]*v.X+m[5]*v.Y+m[6]*v.Z,
+		m[8]*v.X+m[9]*v.Y+m[10]*v.Z,
+	)
+}
+
+// Transpose returns m with its rows and columns swapped
+func (m Mat4) Transpose() Mat4 {
+	return Mat4{
+		m[0], m[4], m[8], m[12],
+		m[1], m[5], m[9], m[13],
+		m[2], m[6], m[10], m[14],
+		m[3], m[7], m[11], m[15],
+	}
+}
+
+// subDets returns the determinants of the 2x2 submatrices
+// built from the top two rows (s) and bottom two rows (c) of m
+func (m Mat4) subDets() (s, c [6]float64) {
+	s[0] = m[0]*m[5] - m[4]*m[1]
+	s[1] = m[0]*m[6] - m[4]*m[2]
+	s[2] = m[0]*m[7] - m[4]*m[3]
+	s[3] = m[1]*m[6] - m[5]*m[2]
+	s[4] = m[1]*m[7] - m[5]*m[3]
+	s[5] = m[2]*m[7] - m[6]*m[3]
+
+	c[0] = m[8]*m[13] - m[12]*m[9]
+	c[1] = m[8]*m[14] - m[12]*m[10]
+	c[2] = m[8]*m[15] - m[12]*m[11]
+	c[3] = m[9]*m[14] - m[13]*m[10]
+	c[4] = m[9]*m[15] - m[13]*m[11]
+	c[5] = m[10]*m[15] - m[14]*m[11]
+	return s, c
+}
+
+// Det returns the determinant of m
+func (m Mat4) Det() float64 {
+	s, c := m.subDets()
+	return s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+}
+
+// Inverse returns the inverse of m, computed in closed form
+// from the determinants of its 2x2 submatrices
+//
+// m is deemed singular only if its determinant is zero or not finite,
+// so that tiny scalings and large translations can still be inverted
+//
+// It returns an error if m is not invertible
+func (m Mat4) Inverse() (Mat4, error) {
+	s, c := m.subDets()
+	det := s[0]*c[5] - s[1]*c[4] + s[2]*c[3] + s[3]*c[2] - s[4]*c[1] + s[5]*c[0]
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return Mat4{}, ErrMatrixNotInvertible
+	}
+	invDet := 1 / det
+	return Mat4{
+		(m[5]*c[5] - m[6]*c[4] + m[7]*c[3]) * invDet,
+		(-m[1]*c[5] + m[2]*c[4] - m[3]*c[3]) * invDet,
+		(m[13]*s[5] - m[14]*s[4] + m[15]*s[3]) * invDet,
+		(-m[9]*s[5] + m[10]*s[4] - m[11]*s[3]) * invDet,
+
+		(-m[4]*c[5] + m[6]*c[2] - m[7]*c[1]) * invDet,
+		(m[0]*c[5] - m[2]*c[2] + m[3]*c[1]) * invDet,
+		(-m[12]*s[5] + m[14]*s[2] - m[15]*s[1]) * invDet,
+		(m[8]*s[5] - m[10]*s[2] + m[11]*s[1]) * invDet,
+
+		(m[4]*c[4] - m[5]*c[2] + m[7]*c[0]) * invDet,
+		(-m[0]*c[4] + m[1]*c[2] - m[3]*c[0]) * invDet,
+		(m[12]*s[4] - m[13]*s[2] + m[15]*s[0]) * invDet,
+		(-m[8]*s[4] + m[9]*s[2] - m[11]*s[0]) * invDet,
+
+		(-m[4]*c[3] + m[5]*c[1] - m[6]*c[0]) * invDet,
+		(m[0]*c[3] - m[1]*c[1] + m[2]*c[0]) * invDet,
+		(-m[12]*s[3] + m[13]*s[1] - m[14]*s[0]) * invDet,
+		(m[8]*s[3] - m[9]*s[1] + m[10]*s[0]) * invDet,
+	}, nil
+}
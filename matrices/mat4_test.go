@@ -0,0 +1,202 @@
+package matrices
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+var testMat4Values = [][]float64{{4, 3, 1.01, 0}, {32, 1.1, 1, -2}, {0, 3, 6.012, 7}, {9, 3.45, -0.34, 12}}
+
+var benchMat4 Mat4
+var benchMatrix Matrix
+var benchPoint tuples.Point
+
+// TestNewMat4FromMatrix checks if 4x4 matrices are converted
+// to Mat4 and back, and that other dimensions are rejected
+func TestNewMat4FromMatrix(t *testing.T) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	m, err := NewMat4FromMatrix(mat)
+	if err != nil {
+		t.Fatalf("No error expected converting a 4x4 matrix, but got: %q", err)
+	}
+	for i := 0; i < 4; i++ {
+		for j := 0; j < 4; j++ {
+			if m.At(i, j) != testMat4Values[i][j] {
+				t.Fatalf("Expected location (%d,%d) to be %f, but got %f", i, j, testMat4Values[i][j], m.At(i, j))
+			}
+		}
+	}
+	if !m.Matrix().IsEqualTo(mat) {
+		t.Fatalf("Expected\n%s\nto convert back to\n%s", m, mat)
+	}
+	if m.String() != mat.String() {
+		t.Fatalf("Expected string representation to be:\n%s\nGot:\n%s\n", mat, m)
+	}
+
+	mat3, _ := NewIdentityMatrix(3)
+	if _, err := NewMat4FromMatrix(mat3); err == nil {
+		t.Fatalf("Expected error converting a 3x3 matrix, but got none")
+	}
+}
+
+// TestMat4Multiply checks if Mat4 products match
+// the ones computed with general matrices
+func TestMat4Multiply(t *testing.T) {
+	matA, _ := NewMatrixFromSlice(testMat4Values)
+	matB, _ := NewMatrixFromSlice([][]float64{{1, 2, 3, 4}, {-2, 0.5, 1, 0}, {0, 0, 1, 9}, {3.3, -1, 0, 1}})
+	expProd, _ := matA.Multiply(matB)
+	a, _ := NewMat4FromMatrix(matA)
+	b, _ := NewMat4FromMatrix(matB)
+	prod := a.Multiply(b)
+	if !prod.Matrix().IsEqualTo(expProd) {
+		t.Fatalf("Expected product to be:\n%s\nbut, got:\n%s\n", expProd, prod)
+	}
+	if !a.Multiply(NewIdentityMat4()).IsEqualTo(a) {
+		t.Fatalf("Expected\n%s\nto remain unchanged after multiplication with identity", a)
+	}
+}
+
+// TestMat4MulTuples checks if points are affected by
+// translation and vectors are not
+func TestMat4MulTuples(t *testing.T) {
+	m := Mat4{
+		2, 0, 0, 1,
+		0, 3, 0, 2,
+		0, 0, 4, 3,
+		0, 0, 0, 1,
+	}
+	p := m.MulPoint(tuples.NewPoint(1, 1, 1))
+	if expP := tuples.NewPoint(3, 5, 7); !p.IsEqualTo(expP) {
+		t.Fatalf("Expected point to be %s, but got %s", expP, p)
+	}
+	v := m.MulVector(tuples.NewVector(1, 1, 1))
+	if expV := tuples.NewVector(2, 3, 4); !v.IsEqualTo(expV) {
+		t.Fatalf("Expected vector to be %s, but got %s", expV, v)
+	}
+}
+
+// TestMat4Transpose checks if Mat4 transposes match
+// the ones computed with general matrices
+func TestMat4Transpose(t *testing.T) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	m, _ := NewMat4FromMatrix(mat)
+	if !m.Transpose().Matrix().IsEqualTo(mat.Transposed()) {
+		t.Fatalf("Expected transpose of\n%s\nto be:\n%s\nbut, got:\n%s\n", m, mat.Transposed(), m.Transpose())
+	}
+}
+
+// TestMat4Inverse checks if Mat4 determinants and inverses
+// match the ones computed with general matrices
+func TestMat4Inverse(t *testing.T) {
+	testCases := []struct {
+		name   string
+		mat    [][]float64
+		expErr bool
+	}{
+		{"identity", [][]float64{{1, 0, 0, 0}, {0, 1, 0, 0}, {0, 0, 1, 0}, {0, 0, 0, 1}}, false},
+		{"non-zero matrix", testMat4Values, false},
+		{"transformation", [][]float64{{-2, 0, 0, 5}, {0, 0.5, 1, -3}, {0, -1, 0.5, 2}, {0, 0, 0, 1}}, false},
+		{"small uniform scale", [][]float64{{1e-3, 0, 0, 0}, {0, 1e-3, 0, 0}, {0, 0, 1e-3, 0}, {0, 0, 0, 1e-3}}, false},
+		{"singular matrix", [][]float64{{1, 2, 3, 4}, {2, 4, 6, 8}, {0, 1, 0, 1}, {1, 0, 1, 0}}, true},
+		{"zero matrix", [][]float64{{0, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}, {0, 0, 0, 0}}, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			mat, _ := NewMatrixFromSlice(testCase.mat)
+			m, _ := NewMat4FromMatrix(mat)
+			expDet, _ := mat.Det()
+			if !utils.FloatEqual(m.Det(), expDet) {
+				t.Fatalf("Expected determinant of\n%s\nto be %f, but got %f", m, expDet, m.Det())
+			}
+			inv, err := m.Inverse()
+			if testCase.expErr {
+				if err == nil {
+					t.Fatalf("Expected error inverting\n%s\nbut received none", m)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error expected inverting\n%s\nbut received one: %q", m, err)
+			}
+			expInv, _ := mat.Inverse()
+			if !inv.Matrix().IsEqualTo(expInv) {
+				t.Fatalf("Expected inverse of\n%s\nto be:\n%s\nbut, got:\n%s\n", m, expInv, inv)
+			}
+			if !m.Multiply(inv).IsEqualTo(NewIdentityMat4()) {
+				t.Fatalf("Expected\n%s\ntimes its inverse to be the identity", m)
+			}
+		})
+	}
+}
+
+// BenchmarkMatrixMultiply multiplies two general 4x4 matrices
+func BenchmarkMatrixMultiply(b *testing.B) {
+	matA, _ := NewMatrixFromSlice(testMat4Values)
+	matB := matA.Transposed()
+	for i := 0; i < b.N; i++ {
+		benchMatrix, _ = matA.Multiply(matB)
+	}
+}
+
+// BenchmarkMat4Multiply multiplies two 4x4 matrices stored by value
+func BenchmarkMat4Multiply(b *testing.B) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	m, _ := NewMat4FromMatrix(mat)
+	n := m.Transpose()
+	for i := 0; i < b.N; i++ {
+		benchMat4 = m.Multiply(n)
+	}
+}
+
+// BenchmarkMatrixInverse inverts a general 4x4 matrix
+func BenchmarkMatrixInverse(b *testing.B) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	for i := 0; i < b.N; i++ {
+		benchMatrix, _ = mat.Inverse()
+	}
+}
+
+// BenchmarkMat4Inverse inverts a 4x4 matrix stored by value
+func BenchmarkMat4Inverse(b *testing.B) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	m, _ := NewMat4FromMatrix(mat)
+	for i := 0; i < b.N; i++ {
+		benchMat4, _ = m.Inverse()
+	}
+}
+
+// BenchmarkMatrixMulPoint transforms a point through
+// a general 4x4 matrix and a column matrix
+func BenchmarkMatrixMulPoint(b *testing.B) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	p := tuples.NewPoint(1, -4, 3.2)
+	for i := 0; i < b.N; i++ {
+		prod, _ := mat.Multiply(NewMatrixFromPoint(p))
+		col, _ := prod.GetCol(0)
+		benchPoint = tuples.NewPoint(col[0], col[1], col[2])
+	}
+}
+
+// BenchmarkMat4MulPoint transforms a point
+// through a 4x4 matrix stored by value
+func BenchmarkMat4MulPoint(b *testing.B) {
+	mat, _ := NewMatrixFromSlice(testMat4Values)
+	m, _ := NewMat4FromMatrix(mat)
+	p := tuples.NewPoint(1, -4, 3.2)
+	for i := 0; i < b.N; i++ {
+		benchPoint = m.MulPoint(p)
+	}
+}
+
+// BenchmarkTransform applies a chain of transformations to a point
+func BenchmarkTransform(b *testing.B) {
+	rotation := NewRotationX(1)
+	scaling := NewScaling(2, 3, 4)
+	translation := NewTranslation(1, 2, 3)
+	p := tuples.NewPoint(1, -4, 3.2)
+	for i := 0; i < b.N; i++ {
+		benchPoint = Transform(p, rotation, scaling, translation)
+	}
+}
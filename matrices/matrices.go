@@ -28,6 +28,8 @@ type Matrix interface {
 	Cofactor(int, int) (float64, error)
 	Minor(int, int) (float64, error)
 	Inverse() (Matrix, error)
+	Solve([]float64) ([]float64, error)
+	Cond() (float64, error)
 }
 
 type matrix struct {
@@ -40,6 +42,7 @@ var (
 	ErrInvalidInitialValues = errors.New("matrices: invalid values for initialization")
 	ErrDimensionMismatch    = errors.New("matrices: matrix dimensions are not compatible for the operation")
 	ErrMatrixNotInvertible  = errors.New("matrics: given matrix is not invertible")
+	ErrZeroVector           = errors.New("matrices: vector must have non-zero length")
 )
 
 // NewMatrix returns a rows X cols matrix initialized with zeros
@@ -272,38 +275,68 @@ func (m *matrix) Cofactor(row, col int) (float64, error) {
 	return minor * math.Pow(-1, float64(col+row)), nil
 }
 
-// Det returns the determinant of matrix m
+// Det returns the determinant of matrix m,
+// computed from its LU decomposition
 func (m *matrix) Det() (float64, error) {
-	if m.Rows() != m.Cols() {
-		return 0, ErrDimensionMismatch
-	}
-	if m.Rows() < 2 {
-		return m.data[0], nil
-	}
-	det := 0.0
-	for colNum := 0; colNum < m.Cols(); colNum++ {
-		cofac, _ := m.Cofactor(0, colNum)
-		val, _ := m.Get(0, colNum)
-		det += val * cofac
+	dec, err := NewLUDecomposition(m)
+	if err != nil {
+		return 0, err
 	}
-	return det, nil
+	return dec.Det(), nil
 }
 
-// Inverse returns the inverse of matrix m
+// Inverse returns the inverse of matrix m,
+// computed from its LU decomposition
+//
+// It returns an error if m is not square, or if any pivot
+// of the decomposition is too small for m to be invertible
 func (m *matrix) Inverse() (Matrix, error) {
-	det, err := m.Det()
+	dec, err := NewLUDecomposition(m)
 	if err != nil {
 		return nil, err
 	}
-	if utils.FloatEqual(0, det) {
-		return nil, ErrMatrixNotInvertible
+	return dec.Inverse()
+}
+
+// Solve returns x satisfying mx = b
+//
+// It returns an error if m is not square, if b doesn't have
+// one value per row of m, or if m is not invertible
+func (m *matrix) Solve(b []float64) ([]float64, error) {
+	dec, err := NewLUDecomposition(m)
+	if err != nil {
+		return nil, err
 	}
-	invMat, _ := NewMatrix(m.Rows(), m.Cols())
-	for i := 0; i < m.Rows(); i++ {
-		for j := 0; j < m.Cols(); j++ {
-			cof, _ := m.Cofactor(i, j)
-			invMat.Set(j, i, cof/det)
+	return dec.Solve(b)
+}
+
+// Cond returns an estimate of the condition number of matrix m
+// in the 1-norm, i.e, how much errors in b can be amplified
+// when solving mx = b
+//
+// It returns an error if m is not square or not invertible
+func (m *matrix) Cond() (float64, error) {
+	dec, err := NewLUDecomposition(m)
+	if err != nil {
+		return 0, err
+	}
+	invNorm, err := dec.InverseNorm1()
+	if err != nil {
+		return 0, err
+	}
+	return m.norm1() * invNorm, nil
+}
+
+// norm1 returns the 1-norm of matrix m,
+// i.e, the largest absolute column sum
+func (m *matrix) norm1() float64 {
+	norm := 0.0
+	for j := 0; j < m.cols; j++ {
+		sum := 0.0
+		for i := 0; i < m.rows; i++ {
+			sum += math.Abs(m.data[i*m.cols+j])
 		}
+		norm = math.Max(norm, sum)
 	}
-	return invMat, nil
+	return norm
 }
@@ -8,111 +8,291 @@ import (
 
 type Transformation interface {
 	Inverse() Transformation
+	InverseTransposed() Transformation
+	Transposed() Transformation
+	IsInvertible() bool
 	String() string
 	Operator() Matrix
+	Mat4() Mat4
 }
 
 type transformation struct {
-	operator Matrix
+	operator          Mat4
+	inverse           *transformation
+	inverseTransposed *transformation
+	transposed        *transformation
+	err               error
+}
+
+// newTransformation returns a transformation applying operator,
+// with its inverse, transpose and inverse-transpose computed once up front
+//
+// The four of them are linked to each other, so moving between them
+// never inverts a matrix again. If operator is not invertible,
+// the inverse is the zero matrix and the error is kept
+// to be reported by IsInvertible
+func newTransformation(operator Mat4) *transformation {
+	inv, err := operator.Inverse()
+	t := &transformation{operator: operator, err: err}
+	tInv := &transformation{operator: inv, err: err}
+	tInvT := &transformation{operator: inv.Transpose(), err: err}
+	tT := &transformation{operator: operator.Transpose(), err: err}
+
+	t.inverse, t.inverseTransposed, t.transposed = tInv, tInvT, tT
+	tInv.inverse, tInv.inverseTransposed, tInv.transposed = t, tT, tInvT
+	tInvT.inverse, tInvT.inverseTransposed, tInvT.transposed = tT, t, tInv
+	tT.inverse, tT.inverseTransposed, tT.transposed = tInvT, tInv, t
+	return t
+}
+
+// NewTransformation returns a transformation applying operator
+//
+// It returns an error if operator is not invertible
+func NewTransformation(operator Mat4) (Transformation, error) {
+	t := newTransformation(operator)
+	if t.err != nil {
+		return nil, t.err
+	}
+	return t, nil
 }
 
 func (t *transformation) Operator() Matrix {
+	return t.operator.Matrix()
+}
+
+func (t *transformation) Mat4() Mat4 {
 	return t.operator
 }
 
+// Inverse returns the cached inverse of t
+//
+// The inverse of a non-invertible transformation is the zero matrix
 func (t *transformation) Inverse() Transformation {
-	invMat, _ := t.operator.Inverse()
-	return &transformation{invMat}
+	return t.inverse
+}
+
+// InverseTransposed returns the cached transpose of the inverse of t,
+// used to move normals between object and world space
+func (t *transformation) InverseTransposed() Transformation {
+	return t.inverseTransposed
+}
+
+// Transposed returns the cached transpose of t
+func (t *transformation) Transposed() Transformation {
+	return t.transposed
+}
+
+// IsInvertible reports whether t can be undone
+func (t *transformation) IsInvertible() bool {
+	return t.err == nil
 }
 
 func (t *transformation) String() string {
 	return t.operator.String()
 }
 
+// NewIdentityTransformation returns a matrix operator
+// that leaves points and vectors unchanged
+func NewIdentityTransformation() Transformation {
+	return newTransformation(NewIdentityMat4())
+}
+
 // NewTranslation returns a matrix operator that translates
 // by the given x,y,z units in x-,y-, and z- axes respectively
 func NewTranslation(x, y, z float64) Transformation {
-	idenMat, _ := NewIdentityMatrix(4)
-	idenMat.Set(0, 3, x)
-	idenMat.Set(1, 3, y)
-	idenMat.Set(2, 3, z)
-	return &transformation{idenMat}
+	return newTransformation(Mat4{
+		1, 0, 0, x,
+		0, 1, 0, y,
+		0, 0, 1, z,
+		0, 0, 0, 1,
+	})
 }
 
 // NewScaling returns a matrix operator that scales
 // by the given x,y,z factors in x-,y-, and z- axes respectively
 func NewScaling(x, y, z float64) Transformation {
-	idenMat, _ := NewIdentityMatrix(4)
-	idenMat.Set(0, 0, x)
-	idenMat.Set(1, 1, y)
-	idenMat.Set(2, 2, z)
-	return &transformation{idenMat}
+	return newTransformation(Mat4{
+		x, 0, 0, 0,
+		0, y, 0, 0,
+		0, 0, z, 0,
+		0, 0, 0, 1,
+	})
 }
 
 // NewRotationX returns a matrix operator that rotates
 // around the x-axis
 func NewRotationX(angle float64) Transformation {
-	rotMat, _ := NewMatrixFromSlice([][]float64{
-		{1, 0, 0, 0},
-		{0, math.Cos(angle), -math.Sin(angle), 0},
-		{0, math.Sin(angle), math.Cos(angle), 0},
-		{0, 0, 0, 1},
+	return newTransformation(Mat4{
+		1, 0, 0, 0,
+		0, math.Cos(angle), -math.Sin(angle), 0,
+		0, math.Sin(angle), math.Cos(angle), 0,
+		0, 0, 0, 1,
 	})
-	return &transformation{rotMat}
 }
 
 // NewRotationY returns a matrix operator that rotates
 // around the Y-axis
 func NewRotationY(angle float64) Transformation {
-	rotMat, _ := NewMatrixFromSlice([][]float64{
-		{math.Cos(angle), 0, math.Sin(angle), 0},
-		{0, 1, 0, 0},
-		{-math.Sin(angle), 0, math.Cos(angle), 0},
-		{0, 0, 0, 1},
+	return newTransformation(Mat4{
+		math.Cos(angle), 0, math.Sin(angle), 0,
+		0, 1, 0, 0,
+		-math.Sin(angle), 0, math.Cos(angle), 0,
+		0, 0, 0, 1,
 	})
-	return &transformation{rotMat}
 }
 
 // NewRotationZ returns a matrix operator that rotates
 // around the z-axis
 func NewRotationZ(angle float64) Transformation {
-	rotMat, _ := NewMatrixFromSlice([][]float64{
-		{math.Cos(angle), -math.Sin(angle), 0, 0},
-		{math.Sin(angle), math.Cos(angle), 0, 0},
-		{0, 0, 1, 0},
-		{0, 0, 0, 1},
+	return newTransformation(Mat4{
+		math.Cos(angle), -math.Sin(angle), 0, 0,
+		math.Sin(angle), math.Cos(angle), 0, 0,
+		0, 0, 1, 0,
+		0, 0, 0, 1,
 	})
-	return &transformation{rotMat}
 }
 
 // NewShear returns a matrix operator that applies
 // shear transformation according to the params provided
 func NewShear(xy, xz, yx, yz, zx, zy float64) Transformation {
-	rotMat, _ := NewMatrixFromSlice([][]float64{
-		{1, xy, xz, 0},
-		{yx, 1, yz, 0},
-		{zx, zy, 1, 0},
-		{0, 0, 0, 1},
+	return newTransformation(Mat4{
+		1, xy, xz, 0,
+		yx, 1, yz, 0,
+		zx, zy, 1, 0,
+		0, 0, 0, 1,
 	})
-	return &transformation{rotMat}
+}
+
+// NewRotationAxis returns a matrix operator that rotates by angle
+// around axis through the origin, following the right hand rule
+//
+// It returns an error if axis has zero length
+func NewRotationAxis(axis tuples.Vector, angle float64) (Transformation, error) {
+	axis, err := axis.Normalized()
+	if err != nil {
+		return nil, ErrZeroVector
+	}
+	x, y, z := axis.X, axis.Y, axis.Z
+	c, s := math.Cos(angle), math.Sin(angle)
+	t := 1 - c
+	return newTransformation(Mat4{
+		t*x*x + c, t*x*y - s*z, t*x*z + s*y, 0,
+		t*x*y + s*z, t*y*y + c, t*y*z - s*x, 0,
+		t*x*z - s*y, t*y*z + s*x, t*z*z + c, 0,
+		0, 0, 0, 1,
+	}), nil
+}
+
+// NewReflection returns a matrix operator that mirrors
+// across the plane through point with the given normal
+//
+// It returns an error if normal has zero length
+func NewReflection(point tuples.Point, normal tuples.Vector) (Transformation, error) {
+	n, err := normal.Normalized()
+	if err != nil {
+		return nil, ErrZeroVector
+	}
+	d := 2 * (n.X*point.X + n.Y*point.Y + n.Z*point.Z)
+	return newTransformation(Mat4{
+		1 - 2*n.X*n.X, -2 * n.X * n.Y, -2 * n.X * n.Z, d * n.X,
+		-2 * n.Y * n.X, 1 - 2*n.Y*n.Y, -2 * n.Y * n.Z, d * n.Y,
+		-2 * n.Z * n.X, -2 * n.Z * n.Y, 1 - 2*n.Z*n.Z, d * n.Z,
+		0, 0, 0, 1,
+	}), nil
+}
+
+// ViewTransform returns a matrix operator that orients the world
+// relative to an eye positioned at from, looking at to,
+// with up pointing roughly upwards
+func ViewTransform(from, to tuples.Point, up tuples.Vector) Transformation {
+	forward, _ := to.Subtract(from).Normalized()
+	upn, _ := up.Normalized()
+	left := forward.Cross(upn)
+	trueUp := left.Cross(forward)
+	orientation := Mat4{
+		left.X, left.Y, left.Z, 0,
+		trueUp.X, trueUp.Y, trueUp.Z, 0,
+		-forward.X, -forward.Y, -forward.Z, 0,
+		0, 0, 0, 1,
+	}
+	return newTransformation(orientation.Multiply(NewTranslation(-from.X, -from.Y, -from.Z).Mat4()))
+}
+
+// NewLookAt returns a matrix operator that places an object
+// at from, with its negative z-axis facing to and its
+// y-axis pointing roughly along up
+//
+// It is the inverse of ViewTransform, so a camera
+// can be moved around like any other object
+func NewLookAt(from, to tuples.Point, up tuples.Vector) Transformation {
+	return ViewTransform(from, to, up).Inverse()
+}
+
+// Decompose splits t into a translation, a rotation and a scale,
+// such that t is the scaling, followed by the rotation,
+// followed by the translation
+//
+// Mirroring is folded into a negative x scale so that
+// the rotation stays proper. Shear can not be represented,
+// and leaves the returned rotation non-orthogonal.
+// It returns an error if t collapses an axis to zero length
+func Decompose(t Transformation) (translation tuples.Vector, rotation Transformation, scale tuples.Vector, err error) {
+	m := t.Mat4()
+	translation = tuples.NewVector(m[3], m[7], m[11])
+	var s [3]float64
+	for j := range s {
+		s[j] = math.Sqrt(m[j]*m[j] + m[4+j]*m[4+j] + m[8+j]*m[8+j])
+		if s[j] == 0 {
+			return tuples.Vector{}, nil, tuples.Vector{}, ErrMatrixNotInvertible
+		}
+	}
+	if m.Det() < 0 {
+		s[0] = -s[0]
+	}
+	r := NewIdentityMat4()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			r[i*4+j] = m[i*4+j] / s[j]
+		}
+	}
+	return translation, newTransformation(r), tuples.NewVector(s[0], s[1], s[2]), nil
+}
+
+// compose returns the operator applying transformations in order
+func compose(transformations []Transformation) Mat4 {
+	operator := NewIdentityMat4()
+	for _, t := range transformations {
+		operator = t.Mat4().Multiply(operator)
+	}
+	return operator
 }
 
 // Transform applies the provided transformations to tup in order
+//
+// Points are affected by translation, while vectors are not.
+// Normals are transformed with TransformNormal
 func Transform[T tuples.Tuple](tup T, transformations ...Transformation) T {
-	operator := NewTranslation(0, 0, 0).Operator()
-	for i := len(transformations) - 1; i >= 0; i-- {
-		operator, _ = operator.Multiply(transformations[i].Operator())
-	}
 	switch t := any(tup).(type) {
-	case tuples.Vector:
-		prod, _ := operator.Multiply(NewMatrixFromVector(t))
-		prodColumn, _ := prod.GetCol(0)
-		return T(tuples.NewVector(prodColumn[0], prodColumn[1], prodColumn[2]))
 	case tuples.Point:
-		prod, _ := operator.Multiply(NewMatrixFromPoint(t))
-		prodColumn, _ := prod.GetCol(0)
-		return T(tuples.NewVector(prodColumn[0], prodColumn[1], prodColumn[2]))
-	default:
-		return T(tuples.NewPoint(0, 0, 0))
+		return any(compose(transformations).MulPoint(t)).(T)
+	case tuples.Vector:
+		return any(compose(transformations).MulVector(t)).(T)
+	case tuples.Normal:
+		return any(TransformNormal(t, transformations...)).(T)
+	}
+	return tup
+}
+
+// TransformNormal applies the provided transformations to normal n in order
+//
+// Each transformation is applied through its inverse-transpose,
+// so that n stays perpendicular to the transformed surface.
+// The returned normal is not normalized
+func TransformNormal(n tuples.Normal, transformations ...Transformation) tuples.Normal {
+	operator := NewIdentityMat4()
+	for _, t := range transformations {
+		operator = t.InverseTransposed().Mat4().Multiply(operator)
 	}
+	v := operator.MulVector(n.Vector())
+	return tuples.NewNormal(v.X, v.Y, v.Z)
 }
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
 )
 
 // TestNewTranslation translates a 3d point and
@@ -17,6 +18,7 @@ func TestNewTranslation(t *testing.T) {
 		expDest     tuples.Point
 	}{
 		{"zero translation", tuples.NewPoint(1, -4, 3.2), NewTranslation(0, 0, 0), tuples.NewPoint(1, -4, 3.2)},
+		{"identity transformation", tuples.NewPoint(1, -4, 3.2), NewIdentityTransformation(), tuples.NewPoint(1, -4, 3.2)},
 		{"X-axis translation", tuples.NewPoint(1, -4, 3.2), NewTranslation(45, 0, 0), tuples.NewPoint(46, -4, 3.2)},
 		{"negative X-axis translation", tuples.NewPoint(1, -4, 3.2), NewTranslation(-45, 0, 0), tuples.NewPoint(-44, -4, 3.2)},
 		{"negative X-axis translation with inverse", tuples.NewPoint(1, -4, 3.2), NewTranslation(45, 0, 0).Inverse(), tuples.NewPoint(-44, -4, 3.2)},
@@ -150,3 +152,275 @@ func TestNewShear(t *testing.T) {
 	}
 }
 
+// TestViewTransform checks if view transformations
+// orient the world relative to the eye as expected
+func TestViewTransform(t *testing.T) {
+	customView, _ := NewMatrixFromSlice([][]float64{
+		{-0.50709255283711, 0.50709255283711, 0.67612340378281, -2.36643191323985},
+		{0.76771593385968, 0.60609152673132, 0.12121830534626, -2.82842712474619},
+		{-0.35856858280032, 0.59761430466720, -0.71713716560064, 0},
+		{0, 0, 0, 1},
+	})
+	testCases := []struct {
+		name    string
+		from    tuples.Point
+		to      tuples.Point
+		up      tuples.Vector
+		expView Matrix
+	}{
+		{"default orientation", tuples.NewPoint(0, 0, 0), tuples.NewPoint(0, 0, -1), tuples.NewVector(0, 1, 0), NewIdentityTransformation().Operator()},
+		{"looking in positive z direction", tuples.NewPoint(0, 0, 0), tuples.NewPoint(0, 0, 1), tuples.NewVector(0, 1, 0), NewScaling(-1, 1, -1).Operator()},
+		{"moves the world", tuples.NewPoint(0, 0, 8), tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0), NewTranslation(0, 0, -8).Operator()},
+		{"arbitrary view", tuples.NewPoint(1, 3, 2), tuples.NewPoint(4, -2, 8), tuples.NewVector(1, 1, 0), customView},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			view := ViewTransform(testCase.from, testCase.to, testCase.up)
+			if !view.Operator().IsEqualTo(testCase.expView) {
+				t.Fatalf("Expected view transform to be\n%s\nbut got\n%s", testCase.expView, view)
+			}
+		})
+	}
+}
+
+// TestTransformTupleKinds transforms points, vectors and normals with
+// the same transformations and checks if each kind is moved correctly
+func TestTransformTupleKinds(t *testing.T) {
+	translation := NewTranslation(5, -3, 2)
+	t.Run("translation moves points", func(t *testing.T) {
+		dest := Transform(tuples.NewPoint(-3, 4, 5), translation)
+		expDest := tuples.NewPoint(2, 1, 7)
+		if !dest.IsEqualTo(expDest) {
+			t.Fatalf("Expected point to be translated to %s, but got %s", expDest, dest)
+		}
+	})
+	t.Run("translation does not affect vectors", func(t *testing.T) {
+		v := tuples.NewVector(-3, 4, 5)
+		if dest := Transform(v, translation); !dest.IsEqualTo(v) {
+			t.Fatalf("Expected vector %s to be unchanged by translation, but got %s", v, dest)
+		}
+	})
+	t.Run("translation does not affect normals", func(t *testing.T) {
+		n := tuples.NewNormal(0, 1, 0)
+		if dest := Transform(n, translation); !dest.IsEqualTo(n) {
+			t.Fatalf("Expected normal %s to be unchanged by translation, but got %s", n, dest)
+		}
+	})
+
+	testCases := []struct {
+		name            string
+		transformations []Transformation
+		normal          tuples.Normal
+		tangent         tuples.Vector
+		expNormal       tuples.Normal
+	}{
+		{
+			"non-uniform scale",
+			[]Transformation{NewScaling(1, 0.5, 1)},
+			tuples.NewNormal(0, 1, 1), tuples.NewVector(0, 1, -1),
+			tuples.NewNormal(0, 2, 1),
+		},
+		{
+			"rotation after non-uniform scale",
+			[]Transformation{NewScaling(4, 1, 1), NewRotationZ(math.Pi / 2)},
+			tuples.NewNormal(1, 1, 0), tuples.NewVector(1, -1, 0),
+			tuples.NewNormal(-1, 0.25, 0),
+		},
+		{
+			"uniform scale and translation",
+			[]Transformation{NewScaling(2, 2, 2), NewTranslation(1, 2, 3)},
+			tuples.NewNormal(0, 0, 1), tuples.NewVector(1, 0, 0),
+			tuples.NewNormal(0, 0, 0.5),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			n := TransformNormal(testCase.normal, testCase.transformations...)
+			if !n.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal to be transformed to %s, but got %s", testCase.expNormal, n)
+			}
+			if generic := Transform(testCase.normal, testCase.transformations...); !generic.IsEqualTo(n) {
+				t.Fatalf("Expected Transform to match TransformNormal, but got %s and %s", generic, n)
+			}
+			tangent := Transform(testCase.tangent, testCase.transformations...)
+			if !utils.FloatEqual(n.Vector().Dot(tangent), 0) {
+				t.Fatalf("Expected normal %s to stay perpendicular to tangent %s", n, tangent)
+			}
+		})
+	}
+}
+
+// TestNewRotationAxis checks if rotations around arbitrary axes
+// match the axis-aligned rotations, and zero axes are rejected
+func TestNewRotationAxis(t *testing.T) {
+	diagonal := tuples.NewVector(1, 1, 1)
+	testCases := []struct {
+		name    string
+		axis    tuples.Vector
+		angle   float64
+		srcVec  tuples.Vector
+		expDest tuples.Vector
+	}{
+		{"x-axis", tuples.NewVector(2, 0, 0), math.Pi / 3, tuples.NewVector(1, 2, 3), Transform(tuples.NewVector(1, 2, 3), NewRotationX(math.Pi/3))},
+		{"y-axis", tuples.NewVector(0, 1, 0), math.Pi / 5, tuples.NewVector(1, 2, 3), Transform(tuples.NewVector(1, 2, 3), NewRotationY(math.Pi/5))},
+		{"negative z-axis", tuples.NewVector(0, 0, -1), math.Pi / 2, tuples.NewVector(1, 0, 0), tuples.NewVector(0, -1, 0)},
+		{"diagonal third turn", diagonal, 2 * math.Pi / 3, tuples.NewVector(1, 0, 0), tuples.NewVector(0, 1, 0)},
+		{"along the axis", diagonal, 1.234, diagonal, diagonal},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rotation, err := NewRotationAxis(testCase.axis, testCase.angle)
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if dest := Transform(testCase.srcVec, rotation); !dest.IsEqualTo(testCase.expDest) {
+				t.Fatalf("Expected %s but got %s", testCase.expDest, dest)
+			}
+		})
+	}
+	if _, err := NewRotationAxis(tuples.NewVector(0, 0, 0), 1); err != ErrZeroVector {
+		t.Fatalf("Expected %v but got %v", ErrZeroVector, err)
+	}
+}
+
+// TestNewReflection checks if points are mirrored
+// across planes through arbitrary points
+func TestNewReflection(t *testing.T) {
+	testCases := []struct {
+		name    string
+		point   tuples.Point
+		normal  tuples.Vector
+		srcPt   tuples.Point
+		expDest tuples.Point
+	}{
+		{"xz-plane", tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0), tuples.NewPoint(1, 2, 3), tuples.NewPoint(1, -2, 3)},
+		{"offset yz-plane", tuples.NewPoint(2, 5, 5), tuples.NewVector(-3, 0, 0), tuples.NewPoint(5, 1, 1), tuples.NewPoint(-1, 1, 1)},
+		{"diagonal plane", tuples.NewPoint(0, 0, 0), tuples.NewVector(1, -1, 0), tuples.NewPoint(3, 1, 7), tuples.NewPoint(1, 3, 7)},
+		{"point on the plane", tuples.NewPoint(1, 1, 1), tuples.NewVector(1, 1, 1), tuples.NewPoint(3, 0, 0), tuples.NewPoint(3, 0, 0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reflection, err := NewReflection(testCase.point, testCase.normal)
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if dest := Transform(testCase.srcPt, reflection); !dest.IsEqualTo(testCase.expDest) {
+				t.Fatalf("Expected %s but got %s", testCase.expDest, dest)
+			}
+		})
+	}
+	if _, err := NewReflection(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 0)); err != ErrZeroVector {
+		t.Fatalf("Expected %v but got %v", ErrZeroVector, err)
+	}
+}
+
+// TestNewLookAt checks if objects are placed at the eye
+// facing the target, undoing the view transform
+func TestNewLookAt(t *testing.T) {
+	from := tuples.NewPoint(1, 3, 2)
+	to := tuples.NewPoint(4, -2, 8)
+	up := tuples.NewVector(1, 1, 0)
+	lookAt := NewLookAt(from, to, up)
+
+	if origin := Transform(tuples.NewPoint(0, 0, 0), lookAt); !origin.IsEqualTo(from) {
+		t.Fatalf("Expected origin to move to %s but got %s", from, origin)
+	}
+	expForward, _ := to.Subtract(from).Normalized()
+	if forward := Transform(tuples.NewVector(0, 0, -1), lookAt); !forward.IsEqualTo(expForward) {
+		t.Fatalf("Expected forward to be %s but got %s", expForward, forward)
+	}
+	view := ViewTransform(from, to, up)
+	if roundTrip := compose([]Transformation{lookAt, view}); !roundTrip.IsEqualTo(NewIdentityMat4()) {
+		t.Fatalf("Expected look-at followed by view to be the identity but got\n%s", roundTrip)
+	}
+}
+
+// mustBuild returns the transformation built by chain,
+// panicking if it is not invertible
+func mustBuild(chain TransformChain) Transformation {
+	transform, err := chain.Build()
+	if err != nil {
+		panic(err)
+	}
+	return transform
+}
+
+// TestDecompose checks if transformations are split into
+// translation, rotation and scale that rebuild the original
+func TestDecompose(t *testing.T) {
+	testCases := []struct {
+		name           string
+		chain          TransformChain
+		expTranslation tuples.Vector
+		expRotation    Transformation
+		expScale       tuples.Vector
+	}{
+		{"identity", NewTransformChain(), tuples.NewVector(0, 0, 0), NewIdentityTransformation(), tuples.NewVector(1, 1, 1)},
+		{"translation", NewTransformChain().Translate(1, -2, 3), tuples.NewVector(1, -2, 3), NewIdentityTransformation(), tuples.NewVector(1, 1, 1)},
+		{"scale, rotate, translate", NewTransformChain().Scale(2, 3, 4).RotateY(math.Pi/6).Translate(5, 6, 7), tuples.NewVector(5, 6, 7), NewRotationY(math.Pi / 6), tuples.NewVector(2, 3, 4)},
+		{"mirror", NewTransformChain().Scale(1, -2, 1).RotateZ(math.Pi / 4), tuples.NewVector(0, 0, 0), mustBuild(NewTransformChain().Scale(-1, -1, 1).RotateZ(math.Pi / 4)), tuples.NewVector(-1, 2, 1)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			transform := mustBuild(testCase.chain)
+			translation, rotation, scale, err := Decompose(transform)
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if !translation.IsEqualTo(testCase.expTranslation) {
+				t.Fatalf("Expected translation %s but got %s", testCase.expTranslation, translation)
+			}
+			if !rotation.Mat4().IsEqualTo(testCase.expRotation.Mat4()) {
+				t.Fatalf("Expected rotation\n%s\nbut got\n%s", testCase.expRotation, rotation)
+			}
+			if !scale.IsEqualTo(testCase.expScale) {
+				t.Fatalf("Expected scale %s but got %s", testCase.expScale, scale)
+			}
+			rebuilt := mustBuild(NewTransformChain().Scale(scale.X, scale.Y, scale.Z).Then(rotation).Translate(translation.X, translation.Y, translation.Z))
+			if !rebuilt.Mat4().IsEqualTo(transform.Mat4()) {
+				t.Fatalf("Expected decomposition to rebuild\n%s\nbut got\n%s", transform, rebuilt)
+			}
+		})
+	}
+	if _, _, _, err := Decompose(NewScaling(1, 0, 1)); err != ErrMatrixNotInvertible {
+		t.Fatalf("Expected %v but got %v", ErrMatrixNotInvertible, err)
+	}
+}
+
+// TestTransformationCache checks that the inverse, transpose and
+// inverse-transpose of a transformation are the same cached values
+// however they are reached
+func TestTransformationCache(t *testing.T) {
+	transform := mustBuild(NewTransformChain().Scale(1, 2, 3).RotateX(math.Pi / 3).Translate(4, -5, 6))
+	inverse := transform.Inverse()
+	inverseTransposed := transform.InverseTransposed()
+	transposed := transform.Transposed()
+	testCases := []struct {
+		name     string
+		got      Transformation
+		expected Transformation
+	}{
+		{"inverse of inverse", inverse.Inverse(), transform},
+		{"inverse-transpose of inverse", inverse.InverseTransposed(), transposed},
+		{"transpose of inverse", inverse.Transposed(), inverseTransposed},
+		{"inverse of inverse-transpose", inverseTransposed.Inverse(), transposed},
+		{"inverse-transpose of inverse-transpose", inverseTransposed.InverseTransposed(), transform},
+		{"transpose of inverse-transpose", inverseTransposed.Transposed(), inverse},
+		{"inverse of transpose", transposed.Inverse(), inverseTransposed},
+		{"inverse-transpose of transpose", transposed.InverseTransposed(), inverse},
+		{"transpose of transpose", transposed.Transposed(), transform},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if testCase.got != testCase.expected {
+				t.Fatalf("Expected the cached\n%s\nbut got\n%s", testCase.expected, testCase.got)
+			}
+		})
+	}
+	if !transposed.Mat4().IsEqualTo(transform.Mat4().Transpose()) {
+		t.Fatalf("Expected transpose\n%s\nbut got\n%s", transform.Mat4().Transpose(), transposed)
+	}
+	if !inverseTransposed.Mat4().IsEqualTo(inverse.Mat4().Transpose()) {
+		t.Fatalf("Expected inverse-transpose\n%s\nbut got\n%s", inverse.Mat4().Transpose(), inverseTransposed)
+	}
+}
@@ -0,0 +1,28 @@
+package patterns
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type checker struct {
+	basePattern
+	a, b canvas.Color
+}
+
+// NewCheckerPattern returns a pattern of unit cubes
+// alternating between colors a and b in all three dimensions
+func NewCheckerPattern(a, b canvas.Color) Pattern {
+	return &checker{basePattern: newBasePattern(), a: a, b: b}
+}
+
+// ColorAt returns a for points in the cube touching the origin
+// from the positive side, and alternates between the cubes from there
+func (p *checker) ColorAt(point tuples.Point) canvas.Color {
+	if isEven(math.Floor(point.X) + math.Floor(point.Y) + math.Floor(point.Z)) {
+		return p.a
+	}
+	return p.b
+}
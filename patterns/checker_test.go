@@ -0,0 +1,23 @@
+package patterns
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestCheckerPattern checks if checkers repeat
+// every unit in all three dimensions
+func TestCheckerPattern(t *testing.T) {
+	checkPattern(t, NewCheckerPattern(white, black), []patternTestCase{
+		{"origin", tuples.NewPoint(0, 0, 0), white},
+		{"just before 1 in x", tuples.NewPoint(0.99, 0, 0), white},
+		{"1 in x", tuples.NewPoint(1.01, 0, 0), black},
+		{"just before 1 in y", tuples.NewPoint(0, 0.99, 0), white},
+		{"1 in y", tuples.NewPoint(0, 1.01, 0), black},
+		{"just before 1 in z", tuples.NewPoint(0, 0, 0.99), white},
+		{"1 in z", tuples.NewPoint(0, 0, 1.01), black},
+		{"diagonal neighbour", tuples.NewPoint(1.5, 1.5, 0.5), white},
+		{"negative cube", tuples.NewPoint(-0.5, 0.5, 0.5), black},
+	})
+}
@@ -0,0 +1,26 @@
+package patterns
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type gradient struct {
+	basePattern
+	a, b canvas.Color
+}
+
+// NewGradientPattern returns a pattern blending linearly from
+// color a to color b along every unit of the x-axis
+func NewGradientPattern(a, b canvas.Color) Pattern {
+	return &gradient{basePattern: newBasePattern(), a: a, b: b}
+}
+
+// ColorAt returns the blend of a and b weighted
+// by the fractional part of the x coordinate of point
+func (p *gradient) ColorAt(point tuples.Point) canvas.Color {
+	fraction := point.X - math.Floor(point.X)
+	return p.a.Add(p.b.Subtract(p.a).Scale(fraction))
+}
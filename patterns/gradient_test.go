@@ -0,0 +1,21 @@
+package patterns
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestGradientPattern checks if gradients blend linearly
+// between the two colors along x
+func TestGradientPattern(t *testing.T) {
+	checkPattern(t, NewGradientPattern(white, black), []patternTestCase{
+		{"origin", tuples.NewPoint(0, 0, 0), white},
+		{"quarter", tuples.NewPoint(0.25, 0, 0), canvas.Color{R: 0.75, G: 0.75, B: 0.75}},
+		{"half", tuples.NewPoint(0.5, 5, 0), canvas.Color{R: 0.5, G: 0.5, B: 0.5}},
+		{"three quarters", tuples.NewPoint(0.75, 0, -5), canvas.Color{R: 0.25, G: 0.25, B: 0.25}},
+		{"repeats every unit", tuples.NewPoint(2, 0, 0), white},
+		{"negative x", tuples.NewPoint(-0.25, 0, 0), canvas.Color{R: 0.25, G: 0.25, B: 0.25}},
+	})
+}
@@ -0,0 +1,64 @@
+// package patterns provides procedural patterns that
+// color the surface of a shape depending on the point hit
+package patterns
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// Pattern is implemented by every procedural pattern
+//
+// ColorAt expects points in pattern space. ColorAtObject
+// takes care of moving object space points into pattern space
+type Pattern interface {
+	Transform() matrices.Transformation
+	SetTransform(matrices.Transformation) error
+	ColorAt(tuples.Point) canvas.Color
+}
+
+// basePattern holds the state shared by every pattern,
+// and is meant to be embedded in their structs
+type basePattern struct {
+	transform matrices.Transformation
+}
+
+// newBasePattern returns a basePattern with the identity transformation
+func newBasePattern() basePattern {
+	return basePattern{transform: matrices.NewIdentityTransformation()}
+}
+
+// Transform returns the transformation applied to pattern p
+func (p *basePattern) Transform() matrices.Transformation {
+	return p.transform
+}
+
+// SetTransform sets the transformation applied to pattern p
+//
+// It returns an error and leaves the transformation unchanged
+// if t is not invertible, since points could not be moved into pattern space
+func (p *basePattern) SetTransform(t matrices.Transformation) error {
+	if !t.IsInvertible() {
+		return matrices.ErrMatrixNotInvertible
+	}
+	p.transform = t
+	return nil
+}
+
+// ColorAtObject returns the color of pattern p at objectPoint
+//
+// The point is moved into pattern space using the inverse
+// of the pattern's transformation, so that the pattern is
+// transformed relative to the object it is applied to
+func ColorAtObject(p Pattern, objectPoint tuples.Point) canvas.Color {
+	return p.ColorAt(matrices.Transform(objectPoint, p.Transform().Inverse()))
+}
+
+// isEven reports whether the integer part of x is even,
+// counting down towards negative infinity
+func isEven(x float64) bool {
+	return int64(math.Floor(x))%2 == 0
+}
@@ -0,0 +1,95 @@
+package patterns
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+var (
+	white = canvas.Color{R: 1, G: 1, B: 1}
+	black = canvas.Color{}
+)
+
+// testPattern is a Pattern returning the pattern space
+// point it was asked about as a color
+type testPattern struct {
+	basePattern
+}
+
+func newTestPattern() *testPattern {
+	return &testPattern{basePattern: newBasePattern()}
+}
+
+func (p *testPattern) ColorAt(point tuples.Point) canvas.Color {
+	return canvas.Color{R: point.X, G: point.Y, B: point.Z}
+}
+
+// TestPatternTransform checks if a pattern starts with
+// the identity transformation, and that it can be changed
+func TestPatternTransform(t *testing.T) {
+	p := newTestPattern()
+	iden := matrices.NewIdentityTransformation()
+	if !p.Transform().Mat4().IsEqualTo(iden.Mat4()) {
+		t.Fatalf("Expected default pattern transform to be\n%s\nbut got\n%s", iden, p.Transform())
+	}
+	translation := matrices.NewTranslation(1, 2, 3)
+	if err := p.SetTransform(translation); err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	if !p.Transform().Mat4().IsEqualTo(translation.Mat4()) {
+		t.Fatalf("Expected pattern transform to be\n%s\nbut got\n%s", translation, p.Transform())
+	}
+	if err := p.SetTransform(matrices.NewScaling(1, 0, 1)); err != matrices.ErrMatrixNotInvertible {
+		t.Fatalf("Expected %v but got %v", matrices.ErrMatrixNotInvertible, err)
+	}
+	if !p.Transform().Mat4().IsEqualTo(translation.Mat4()) {
+		t.Fatalf("Expected pattern transform to stay\n%s\nbut got\n%s", translation, p.Transform())
+	}
+}
+
+// TestColorAtObject checks if object space points
+// are moved into pattern space
+func TestColorAtObject(t *testing.T) {
+	testCases := []struct {
+		name      string
+		transform matrices.Transformation
+		point     tuples.Point
+		expColor  canvas.Color
+	}{
+		{"identity", matrices.NewIdentityTransformation(), tuples.NewPoint(1, 2, 3), canvas.Color{R: 1, G: 2, B: 3}},
+		{"scaled pattern", matrices.NewScaling(2, 2, 2), tuples.NewPoint(2, 3, 4), canvas.Color{R: 1, G: 1.5, B: 2}},
+		{"translated pattern", matrices.NewTranslation(0.5, 1, 1.5), tuples.NewPoint(2.5, 3, 3.5), canvas.Color{R: 2, G: 2, B: 2}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := newTestPattern()
+			p.SetTransform(testCase.transform)
+			if color := ColorAtObject(p, testCase.point); !color.IsEqualTo(testCase.expColor) {
+				t.Fatalf("Expected color at %s to be %s but got %s", testCase.point, testCase.expColor, color)
+			}
+		})
+	}
+}
+
+// patternTestCase is a point sampled on a pattern
+// along with the color expected there
+type patternTestCase struct {
+	name     string
+	point    tuples.Point
+	expColor canvas.Color
+}
+
+// checkPattern samples pattern p at every test case
+func checkPattern(t *testing.T, p Pattern, testCases []patternTestCase) {
+	t.Helper()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if color := p.ColorAt(testCase.point); !color.IsEqualTo(testCase.expColor) {
+				t.Fatalf("Expected color at %s to be %s but got %s", testCase.point, testCase.expColor, color)
+			}
+		})
+	}
+}
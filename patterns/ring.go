@@ -0,0 +1,28 @@
+package patterns
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type ring struct {
+	basePattern
+	a, b canvas.Color
+}
+
+// NewRingPattern returns a pattern of concentric rings around
+// the y-axis, alternating between colors a and b every unit
+func NewRingPattern(a, b canvas.Color) Pattern {
+	return &ring{basePattern: newBasePattern(), a: a, b: b}
+}
+
+// ColorAt returns a for points whose distance from
+// the y-axis is in [0,1), and alternates from there
+func (p *ring) ColorAt(point tuples.Point) canvas.Color {
+	if isEven(math.Hypot(point.X, point.Z)) {
+		return p.a
+	}
+	return p.b
+}
@@ -0,0 +1,20 @@
+package patterns
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestRingPattern checks if rings alternate
+// with the distance from the y-axis
+func TestRingPattern(t *testing.T) {
+	checkPattern(t, NewRingPattern(white, black), []patternTestCase{
+		{"origin", tuples.NewPoint(0, 0, 0), white},
+		{"constant in y", tuples.NewPoint(0, 5, 0), white},
+		{"1 in x", tuples.NewPoint(1, 0, 0), black},
+		{"1 in z", tuples.NewPoint(0, 0, 1), black},
+		{"just beyond 1 diagonally", tuples.NewPoint(0.708, 0, 0.708), black},
+		{"2 in x", tuples.NewPoint(-2, 0, 0), white},
+	})
+}
@@ -0,0 +1,26 @@
+package patterns
+
+import (
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type stripe struct {
+	basePattern
+	a, b canvas.Color
+}
+
+// NewStripePattern returns a pattern alternating between
+// colors a and b every unit along the x-axis
+func NewStripePattern(a, b canvas.Color) Pattern {
+	return &stripe{basePattern: newBasePattern(), a: a, b: b}
+}
+
+// ColorAt returns a for points whose x is in [0,1),
+// and alternates between b and a every unit from there
+func (p *stripe) ColorAt(point tuples.Point) canvas.Color {
+	if isEven(point.X) {
+		return p.a
+	}
+	return p.b
+}
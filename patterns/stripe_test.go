@@ -0,0 +1,22 @@
+package patterns
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestStripePattern checks if stripes alternate along x
+// and stay constant along y and z
+func TestStripePattern(t *testing.T) {
+	checkPattern(t, NewStripePattern(white, black), []patternTestCase{
+		{"origin", tuples.NewPoint(0, 0, 0), white},
+		{"constant in y", tuples.NewPoint(0, 2, 0), white},
+		{"constant in z", tuples.NewPoint(0, 0, 2), white},
+		{"just before 1 in x", tuples.NewPoint(0.9, 0, 0), white},
+		{"1 in x", tuples.NewPoint(1, 0, 0), black},
+		{"just below 0 in x", tuples.NewPoint(-0.1, 0, 0), black},
+		{"-1 in x", tuples.NewPoint(-1, 0, 0), black},
+		{"just below -1 in x", tuples.NewPoint(-1.1, 0, 0), white},
+	})
+}
@@ -0,0 +1,195 @@
+// package quaternions provides the Quaternion type used to represent,
+// compose and interpolate rotations without gimbal lock
+package quaternions
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/schapagain/raytracer/errors"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// Quaternion is a four dimensional number W + Xi + Yj + Zk
+//
+// Unit quaternions represent rotations in 3D space
+type Quaternion struct {
+	W, X, Y, Z float64
+}
+
+// NewQuaternion returns a new Quaternion with the given components
+func NewQuaternion(w, x, y, z float64) Quaternion {
+	return Quaternion{W: w, X: x, Y: y, Z: z}
+}
+
+// NewIdentityQuaternion returns the quaternion
+// representing no rotation at all
+func NewIdentityQuaternion() Quaternion {
+	return NewQuaternion(1, 0, 0, 0)
+}
+
+// NewAxisAngle returns the unit quaternion that rotates
+// by angle (in radians) around axis, following the right hand rule
+//
+// It returns an error if axis has zero length
+func NewAxisAngle(axis tuples.Vector, angle float64) (Quaternion, error) {
+	axis, err := axis.Normalized()
+	if err != nil {
+		return Quaternion{}, err
+	}
+	s := math.Sin(angle / 2)
+	return NewQuaternion(math.Cos(angle/2), axis.X*s, axis.Y*s, axis.Z*s), nil
+}
+
+// NewQuaternionFromTransformation returns the unit quaternion
+// representing the rotation part of transformation t,
+// as split off by matrices.Decompose
+//
+// It returns an error if t can not be decomposed
+func NewQuaternionFromTransformation(t matrices.Transformation) (Quaternion, error) {
+	_, rotation, _, err := matrices.Decompose(t)
+	if err != nil {
+		return Quaternion{}, err
+	}
+	m := rotation.Mat4()
+	// r returns the rotation matrix entry at row i and column j
+	r := func(i, j int) float64 {
+		return m.At(i, j)
+	}
+
+	// pick the largest component first to keep the division stable
+	var q Quaternion
+	trace := r(0, 0) + r(1, 1) + r(2, 2)
+	switch {
+	case trace > 0:
+		s := 2 * math.Sqrt(trace+1)
+		q = NewQuaternion(s/4, (r(2, 1)-r(1, 2))/s, (r(0, 2)-r(2, 0))/s, (r(1, 0)-r(0, 1))/s)
+	case r(0, 0) > r(1, 1) && r(0, 0) > r(2, 2):
+		s := 2 * math.Sqrt(1+r(0, 0)-r(1, 1)-r(2, 2))
+		q = NewQuaternion((r(2, 1)-r(1, 2))/s, s/4, (r(0, 1)+r(1, 0))/s, (r(0, 2)+r(2, 0))/s)
+	case r(1, 1) > r(2, 2):
+		s := 2 * math.Sqrt(1+r(1, 1)-r(0, 0)-r(2, 2))
+		q = NewQuaternion((r(0, 2)-r(2, 0))/s, (r(0, 1)+r(1, 0))/s, s/4, (r(1, 2)+r(2, 1))/s)
+	default:
+		s := 2 * math.Sqrt(1+r(2, 2)-r(0, 0)-r(1, 1))
+		q = NewQuaternion((r(1, 0)-r(0, 1))/s, (r(0, 2)+r(2, 0))/s, (r(1, 2)+r(2, 1))/s, s/4)
+	}
+	return q.Normalized()
+}
+
+// String returns the string representation of q
+func (q Quaternion) String() string {
+	return fmt.Sprintf("[%.3f,(%.3f,%.3f,%.3f)]", q.W, q.X, q.Y, q.Z)
+}
+
+// IsEqualTo reports whether q1 and q2 are equal
+// by performing element-wise float comparison
+func (q1 Quaternion) IsEqualTo(q2 Quaternion) bool {
+	return utils.FloatEqual(q1.W, q2.W) &&
+		utils.FloatEqual(q1.X, q2.X) &&
+		utils.FloatEqual(q1.Y, q2.Y) &&
+		utils.FloatEqual(q1.Z, q2.Z)
+}
+
+// IsSameRotation reports whether q1 and q2 represent the same rotation.
+// Unlike IsEqualTo, it treats q and -q as equal
+func (q1 Quaternion) IsSameRotation(q2 Quaternion) bool {
+	return q1.IsEqualTo(q2) || q1.IsEqualTo(q2.Scale(-1))
+}
+
+// Add returns the component-wise sum of q1 and q2
+func (q1 Quaternion) Add(q2 Quaternion) Quaternion {
+	return NewQuaternion(q1.W+q2.W, q1.X+q2.X, q1.Y+q2.Y, q1.Z+q2.Z)
+}
+
+// Scale returns q with every component multiplied by scaler s
+func (q Quaternion) Scale(s float64) Quaternion {
+	return NewQuaternion(q.W*s, q.X*s, q.Y*s, q.Z*s)
+}
+
+// Multiply returns the Hamilton product of q1 and q2
+//
+// As a rotation, the product applies q2 first and then q1
+func (q1 Quaternion) Multiply(q2 Quaternion) Quaternion {
+	return NewQuaternion(
+		q1.W*q2.W-q1.X*q2.X-q1.Y*q2.Y-q1.Z*q2.Z,
+		q1.W*q2.X+q1.X*q2.W+q1.Y*q2.Z-q1.Z*q2.Y,
+		q1.W*q2.Y-q1.X*q2.Z+q1.Y*q2.W+q1.Z*q2.X,
+		q1.W*q2.Z+q1.X*q2.Y-q1.Y*q2.X+q1.Z*q2.W,
+	)
+}
+
+// Conjugate returns q with its vector part negated,
+// which is the inverse rotation for unit quaternions
+func (q Quaternion) Conjugate() Quaternion {
+	return NewQuaternion(q.W, -q.X, -q.Y, -q.Z)
+}
+
+// Dot returns the four dimensional dot product of q1 and q2
+func (q1 Quaternion) Dot(q2 Quaternion) float64 {
+	return q1.W*q2.W + q1.X*q2.X + q1.Y*q2.Y + q1.Z*q2.Z
+}
+
+// Magnitude returns the length of q
+func (q Quaternion) Magnitude() float64 {
+	return math.Sqrt(q.Dot(q))
+}
+
+// Normalized returns the unit length version of q
+//
+// Returns an error if q has zero length
+func (q Quaternion) Normalized() (Quaternion, error) {
+	mag := q.Magnitude()
+	if utils.FloatEqual(0, mag) {
+		return Quaternion{}, &errors.DivisionByZeroError{
+			Details: fmt.Sprintf("Cannot normalize %s with zero length", q)}
+	}
+	return q.Scale(1 / mag), nil
+}
+
+// Slerp returns the spherical linear interpolation between unit
+// quaternions q1 and q2, where t=0 gives q1 and t=1 gives q2
+//
+// The interpolation follows the shortest arc, moving
+// at a constant angular speed as t goes from 0 to 1
+func (q1 Quaternion) Slerp(q2 Quaternion, t float64) Quaternion {
+	cosTheta := q1.Dot(q2)
+	// q2 and -q2 are the same rotation, so take the shorter way around
+	if cosTheta < 0 {
+		q2 = q2.Scale(-1)
+		cosTheta = -cosTheta
+	}
+	// nearly parallel quaternions make sin(theta) vanish,
+	// where linear interpolation is accurate enough
+	if cosTheta > 1-utils.FloatDiffThreshold {
+		q, err := q1.Scale(1 - t).Add(q2.Scale(t)).Normalized()
+		if err != nil {
+			return q1
+		}
+		return q
+	}
+	theta := math.Acos(cosTheta)
+	sinTheta := math.Sin(theta)
+	return q1.Scale(math.Sin((1-t)*theta) / sinTheta).Add(q2.Scale(math.Sin(t*theta) / sinTheta))
+}
+
+// RotateVector returns v rotated by unit quaternion q
+func (q Quaternion) RotateVector(v tuples.Vector) tuples.Vector {
+	p := q.Multiply(NewQuaternion(0, v.X, v.Y, v.Z)).Multiply(q.Conjugate())
+	return tuples.NewVector(p.X, p.Y, p.Z)
+}
+
+// ToTransformation returns the rotation represented by
+// unit quaternion q as a matrix operator
+func (q Quaternion) ToTransformation() matrices.Transformation {
+	w, x, y, z := q.W, q.X, q.Y, q.Z
+	t, _ := matrices.NewTransformation(matrices.Mat4{
+		1 - 2*(y*y+z*z), 2 * (x*y - w*z), 2 * (x*z + w*y), 0,
+		2 * (x*y + w*z), 1 - 2*(x*x+z*z), 2 * (y*z - w*x), 0,
+		2 * (x*z - w*y), 2 * (y*z + w*x), 1 - 2*(x*x+y*y), 0,
+		0, 0, 0, 1,
+	})
+	return t
+}
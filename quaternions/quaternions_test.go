@@ -0,0 +1,175 @@
+package quaternions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestNewAxisAngle checks if axis-angle quaternions
+// are built from the normalized axis and half the angle
+func TestNewAxisAngle(t *testing.T) {
+	testCases := []struct {
+		name  string
+		axis  tuples.Vector
+		angle float64
+		expQ  Quaternion
+	}{
+		{"no rotation", tuples.NewVector(1, 0, 0), 0, NewIdentityQuaternion()},
+		{"half turn around y", tuples.NewVector(0, 1, 0), math.Pi, NewQuaternion(0, 0, 1, 0)},
+		{"quarter turn around unnormalized z", tuples.NewVector(0, 0, 5), math.Pi / 2, NewQuaternion(math.Sqrt2/2, 0, 0, math.Sqrt2/2)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q, err := NewAxisAngle(testCase.axis, testCase.angle)
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if !q.IsEqualTo(testCase.expQ) {
+				t.Fatalf("Expected %s but got %s", testCase.expQ, q)
+			}
+		})
+	}
+	if _, err := NewAxisAngle(tuples.NewVector(0, 0, 0), 1); err == nil {
+		t.Fatalf("Expected an error for a zero axis but got none")
+	}
+}
+
+// TestMultiply checks the Hamilton product and that
+// the product of rotations applies the right operand first
+func TestMultiply(t *testing.T) {
+	i := NewQuaternion(0, 1, 0, 0)
+	j := NewQuaternion(0, 0, 1, 0)
+	k := NewQuaternion(0, 0, 0, 1)
+	if !i.Multiply(j).IsEqualTo(k) {
+		t.Fatalf("Expected i*j to be %s but got %s", k, i.Multiply(j))
+	}
+	if !j.Multiply(i).IsEqualTo(k.Scale(-1)) {
+		t.Fatalf("Expected j*i to be %s but got %s", k.Scale(-1), j.Multiply(i))
+	}
+
+	qy, _ := NewAxisAngle(tuples.NewVector(0, 1, 0), math.Pi/2)
+	qz, _ := NewAxisAngle(tuples.NewVector(0, 0, 1), math.Pi/2)
+	v := qz.Multiply(qy).RotateVector(tuples.NewVector(0, 0, 1))
+	expV := tuples.NewVector(0, 1, 0)
+	if !v.IsEqualTo(expV) {
+		t.Fatalf("Expected %s but got %s", expV, v)
+	}
+}
+
+// TestNormalized checks if quaternions are scaled to unit length
+// and zero quaternions are rejected
+func TestNormalized(t *testing.T) {
+	q, err := NewQuaternion(1, 2, 2, 4).Normalized()
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	expQ := NewQuaternion(0.2, 0.4, 0.4, 0.8)
+	if !q.IsEqualTo(expQ) {
+		t.Fatalf("Expected %s but got %s", expQ, q)
+	}
+	if _, err := NewQuaternion(0, 0, 0, 0).Normalized(); err == nil {
+		t.Fatalf("Expected an error for a zero quaternion but got none")
+	}
+}
+
+// TestRotateVector checks if vectors are rotated
+// the same way as with the matrix operators
+func TestRotateVector(t *testing.T) {
+	testCases := []struct {
+		name      string
+		axis      tuples.Vector
+		angle     float64
+		transform matrices.Transformation
+	}{
+		{"around x", tuples.NewVector(1, 0, 0), math.Pi / 4, matrices.NewRotationX(math.Pi / 4)},
+		{"around y", tuples.NewVector(0, 1, 0), math.Pi / 3, matrices.NewRotationY(math.Pi / 3)},
+		{"around z", tuples.NewVector(0, 0, 1), -math.Pi / 2, matrices.NewRotationZ(-math.Pi / 2)},
+	}
+	src := tuples.NewVector(1, 2, 3)
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q, _ := NewAxisAngle(testCase.axis, testCase.angle)
+			expV := matrices.Transform(src, testCase.transform)
+			if v := q.RotateVector(src); !v.IsEqualTo(expV) {
+				t.Fatalf("Expected %s but got %s", expV, v)
+			}
+		})
+	}
+}
+
+// TestSlerp checks interpolation end points, midpoints
+// and that the shortest arc is taken
+func TestSlerp(t *testing.T) {
+	q1 := NewIdentityQuaternion()
+	q2, _ := NewAxisAngle(tuples.NewVector(0, 0, 1), math.Pi/2)
+	mid, _ := NewAxisAngle(tuples.NewVector(0, 0, 1), math.Pi/4)
+	testCases := []struct {
+		name string
+		q1   Quaternion
+		q2   Quaternion
+		t    float64
+		expQ Quaternion
+	}{
+		{"start", q1, q2, 0, q1},
+		{"end", q1, q2, 1, q2},
+		{"midpoint", q1, q2, 0.5, mid},
+		{"shortest arc", q1, q2.Scale(-1), 0.5, mid},
+		{"equal quaternions", q2, q2, 0.3, q2},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q := testCase.q1.Slerp(testCase.q2, testCase.t)
+			if !q.IsSameRotation(testCase.expQ) {
+				t.Fatalf("Expected %s but got %s", testCase.expQ, q)
+			}
+		})
+	}
+}
+
+// TestTransformationConversion checks if quaternions round trip
+// through matrix operators
+func TestTransformationConversion(t *testing.T) {
+	testCases := []struct {
+		name  string
+		axis  tuples.Vector
+		angle float64
+	}{
+		{"identity", tuples.NewVector(0, 1, 0), 0},
+		{"around x", tuples.NewVector(1, 0, 0), math.Pi / 3},
+		{"half turn around y", tuples.NewVector(0, 1, 0), math.Pi},
+		{"half turn around z", tuples.NewVector(0, 0, 1), math.Pi},
+		{"arbitrary axis", tuples.NewVector(1, -2, 3), 2.5},
+	}
+	src := tuples.NewPoint(1, -1, 2)
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			q, _ := NewAxisAngle(testCase.axis, testCase.angle)
+			transform := q.ToTransformation()
+			expPt := tuples.Point(q.RotateVector(tuples.Vector(src)))
+			if pt := matrices.Transform(src, transform); !pt.IsEqualTo(expPt) {
+				t.Fatalf("Expected %s but got %s", expPt, pt)
+			}
+			back, err := NewQuaternionFromTransformation(transform)
+			if err != nil {
+				t.Fatalf("Expected no error but got %v", err)
+			}
+			if !back.IsSameRotation(q) {
+				t.Fatalf("Expected %s but got %s", q, back)
+			}
+		})
+	}
+
+	scaledRotation := matrices.NewTransformChain().Scale(2, 3, 4).RotateX(math.Pi/3).Translate(1, 2, 3)
+	transform, _ := scaledRotation.Build()
+	q, err := NewQuaternionFromTransformation(transform)
+	if err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	expQ, _ := NewAxisAngle(tuples.NewVector(1, 0, 0), math.Pi/3)
+	if !q.IsSameRotation(expQ) {
+		t.Fatalf("Expected %s but got %s", expQ, q)
+	}
+}
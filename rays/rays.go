@@ -0,0 +1,46 @@
+// package rays provides the Ray type used to probe
+// a scene, along with methods to walk and transform rays
+package rays
+
+import (
+	"fmt"
+
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type Ray struct {
+	Origin    tuples.Point
+	Direction tuples.Vector
+}
+
+// NewRay returns a new Ray starting at origin
+// and pointing along direction
+func NewRay(origin tuples.Point, direction tuples.Vector) Ray {
+	return Ray{Origin: origin, Direction: direction}
+}
+
+// String returns the string representation of r
+func (r Ray) String() string {
+	return fmt.Sprintf("%s->%s", r.Origin, r.Direction)
+}
+
+// IsEqualTo reports whether r1 and r2 have equal origins and directions
+func (r1 Ray) IsEqualTo(r2 Ray) bool {
+	return r1.Origin.IsEqualTo(r2.Origin) && r1.Direction.IsEqualTo(r2.Direction)
+}
+
+// Position returns the point reached after travelling
+// t units of the direction vector along the ray r
+func (r Ray) Position(t float64) tuples.Point {
+	return r.Origin.Move(r.Direction.Multiply(t))
+}
+
+// Transform returns a new ray with the provided transformations
+// applied in order to both the origin and direction of r
+func (r Ray) Transform(transformations ...matrices.Transformation) Ray {
+	return NewRay(
+		matrices.Transform(r.Origin, transformations...),
+		matrices.Transform(r.Direction, transformations...),
+	)
+}
@@ -0,0 +1,103 @@
+package rays
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// TestRayStringRepr creates a ray and
+// checks if its String() method returns
+// the expected string format
+func TestRayStringRepr(t *testing.T) {
+	r := NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, -1, 0))
+	rStringExpected := "(1.000,2.000,3.000)-><0.000,-1.000,0.000>"
+	if r.String() != rStringExpected {
+		t.Fatalf("Expected %s, but got %s", rStringExpected, r)
+	}
+}
+
+// TestNewRay creates a ray and checks if
+// its origin and direction are set
+func TestNewRay(t *testing.T) {
+	origin := tuples.NewPoint(1, 2, 3)
+	direction := tuples.NewVector(4, 5, 6)
+	r := NewRay(origin, direction)
+	if !r.Origin.IsEqualTo(origin) {
+		t.Fatalf("Expected ray origin to be %s, but got %s", origin, r.Origin)
+	}
+	if !r.Direction.IsEqualTo(direction) {
+		t.Fatalf("Expected ray direction to be %s, but got %s", direction, r.Direction)
+	}
+}
+
+// TestRayPosition checks if points at distance t
+// along a ray are computed correctly
+func TestRayPosition(t *testing.T) {
+	r := NewRay(tuples.NewPoint(2, 3, 4), tuples.NewVector(1, 0, 0))
+	testCases := []struct {
+		name   string
+		t      float64
+		expPos tuples.Point
+	}{
+		{"at origin", 0, tuples.NewPoint(2, 3, 4)},
+		{"one unit forward", 1, tuples.NewPoint(3, 3, 4)},
+		{"one unit backward", -1, tuples.NewPoint(1, 3, 4)},
+		{"fractional distance", 2.5, tuples.NewPoint(4.5, 3, 4)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			pos := r.Position(testCase.t)
+			if !pos.IsEqualTo(testCase.expPos) {
+				t.Fatalf("Expected position of %s at t=%f to be %s, but got %s", r, testCase.t, testCase.expPos, pos)
+			}
+		})
+	}
+}
+
+// TestRayTransform transforms rays and checks if
+// both origin and direction are transformed as expected
+func TestRayTransform(t *testing.T) {
+	testCases := []struct {
+		name           string
+		r              Ray
+		transformation matrices.Transformation
+		expRay         Ray
+	}{
+		{
+			"translation",
+			NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, 1, 0)),
+			matrices.NewTranslation(3, 4, 5),
+			NewRay(tuples.NewPoint(4, 6, 8), tuples.NewVector(0, 1, 0)),
+		},
+		{
+			"scaling",
+			NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, 1, 0)),
+			matrices.NewScaling(2, 3, 4),
+			NewRay(tuples.NewPoint(2, 6, 12), tuples.NewVector(0, 3, 0)),
+		},
+		{
+			"scaling with inverse",
+			NewRay(tuples.NewPoint(2, 6, 12), tuples.NewVector(0, 3, 0)),
+			matrices.NewScaling(2, 3, 4).Inverse(),
+			NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, 1, 0)),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			transformed := testCase.r.Transform(testCase.transformation)
+			if !transformed.IsEqualTo(testCase.expRay) {
+				t.Fatalf("Expected\n%s\nto transform %s to %s, but got %s instead", testCase.transformation, testCase.r, testCase.expRay, transformed)
+			}
+		})
+	}
+
+	t.Run("original ray is unchanged", func(t *testing.T) {
+		r := NewRay(tuples.NewPoint(1, 2, 3), tuples.NewVector(0, 1, 0))
+		r.Transform(matrices.NewTranslation(3, 4, 5))
+		if !r.Origin.IsEqualTo(tuples.NewPoint(1, 2, 3)) {
+			t.Fatalf("Expected original ray to remain unchanged, but got %s", r)
+		}
+	})
+}
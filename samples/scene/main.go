@@ -0,0 +1,65 @@
+package main
+
+import (
+	"context"
+	"log"
+	"math"
+
+	"github.com/schapagain/raytracer/camera"
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/lights"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/patterns"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/world"
+)
+
+func main() {
+	floor := shapes.NewPlane()
+	floorMaterial := materials.NewMaterial()
+	floorMaterial.Pattern = patterns.NewCheckerPattern(canvas.Color{R: 1, G: 0.9, B: 0.9}, canvas.Color{R: 0.6, G: 0.5, B: 0.5})
+	floorMaterial.Specular = 0
+	floor.SetMaterial(floorMaterial)
+
+	middle := shapes.NewSphere()
+	middle.SetTransform(matrices.NewTranslation(-0.5, 1, 0.5))
+	middleMaterial := materials.NewMaterial()
+	middleMaterial.Color = canvas.Color{R: 0.1, G: 1, B: 0.5}
+	middleMaterial.Diffuse = 0.7
+	middleMaterial.Specular = 0.3
+	middle.SetMaterial(middleMaterial)
+
+	right := shapes.NewSphere()
+	rightTransform, _ := matrices.NewTransformChain().Scale(0.5, 0.5, 0.5).Translate(1.5, 0.5, -0.5).Build()
+	right.SetTransform(rightTransform)
+	rightMaterial := materials.NewMaterial()
+	rightMaterial.Color = canvas.Color{R: 0.5, G: 1, B: 0.1}
+	rightMaterial.Diffuse = 0.7
+	rightMaterial.Specular = 0.3
+	right.SetMaterial(rightMaterial)
+
+	left := shapes.NewSphere()
+	leftTransform, _ := matrices.NewTransformChain().Scale(0.33, 0.33, 0.33).Translate(-1.5, 0.33, -0.75).Build()
+	left.SetTransform(leftTransform)
+	leftMaterial := materials.NewMaterial()
+	leftMaterial.Color = canvas.Color{R: 1, G: 0.8, B: 0.1}
+	leftMaterial.Diffuse = 0.7
+	leftMaterial.Specular = 0.3
+	left.SetMaterial(leftMaterial)
+
+	w := world.NewWorld()
+	w.AddObject(floor, middle, right, left)
+	w.AddLight(lights.NewPointLight(tuples.NewPoint(-10, 10, -10), canvas.Color{R: 1, G: 1, B: 1}))
+
+	c := camera.NewCamera(200, 100, math.Pi/3)
+	c.SetTransform(matrices.ViewTransform(tuples.NewPoint(0, 1.5, -5), tuples.NewPoint(0, 1, 0), tuples.NewVector(0, 1, 0)))
+	image, _ := c.RenderParallel(context.Background(), w, 0)
+	if err := canvas.SavePPM(image, "scene.ppm", canvas.PPMOptions{}); err != nil {
+		log.Fatalf("saving scene.ppm: %v", err)
+	}
+	if err := canvas.SavePNG(image, "scene.png"); err != nil {
+		log.Fatalf("saving scene.png: %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+func main() {
+	canvasPixels := 200
+	wallZ := 10.0
+	wallSize := 7.0
+	pixelSize := wallSize / float64(canvasPixels)
+	half := wallSize / 2
+
+	c := canvas.NewCanvas(canvasPixels, canvasPixels)
+	rayOrigin := tuples.NewPoint(0, 0, -5)
+	s := shapes.NewSphere()
+	s.SetTransform(matrices.NewShear(1, 0, 0, 0, 0, 0))
+
+	for y := 0; y < canvasPixels; y++ {
+		worldY := half - pixelSize*float64(y)
+		for x := 0; x < canvasPixels; x++ {
+			worldX := -half + pixelSize*float64(x)
+			target := tuples.NewPoint(worldX, worldY, wallZ)
+			direction, _ := target.Subtract(rayOrigin).Normalized()
+			if _, ok := shapes.Intersect(s, rays.NewRay(rayOrigin, direction)).Hit(); ok {
+				c.SetPixelAt(x, y, canvas.Color{R: 1})
+			}
+		}
+	}
+	c.ToPPM().Save("silhouette.ppm")
+}
@@ -0,0 +1,72 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+type cone struct {
+	baseShape
+	minimum, maximum float64
+	closed           bool
+}
+
+// NewCone returns a double-napped cone around the y-axis, with its
+// apex at the origin and a radius of |y| at height y, truncated at
+// y=minimum and y=maximum (both excluded), with the identity
+// transformation and the default material
+//
+// If closed is set, the ends are capped. Pass math.Inf(-1) and
+// math.Inf(1) for an infinitely long cone
+func NewCone(minimum, maximum float64, closed bool) Shape {
+	return &cone{baseShape: newBaseShape(), minimum: minimum, maximum: maximum, closed: closed}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with cone c, sorted in increasing order of t
+//
+// Rays parallel to one of the halves of the cone
+// hit its side at most once
+func (c *cone) LocalIntersect(r rays.Ray) Intersections {
+	xs := Intersections{}
+	o, d := r.Origin, r.Direction
+	a := d.X*d.X - d.Y*d.Y + d.Z*d.Z
+	b := 2 * (o.X*d.X - o.Y*d.Y + o.Z*d.Z)
+	cc := o.X*o.X - o.Y*o.Y + o.Z*o.Z
+	switch {
+	case math.Abs(a) >= utils.FloatDiffThreshold:
+		discriminant := b*b - 4*a*cc
+		if discriminant >= 0 {
+			t0 := (-b - math.Sqrt(discriminant)) / (2 * a)
+			t1 := (-b + math.Sqrt(discriminant)) / (2 * a)
+			xs = append(xs, sideHits(c, r, c.minimum, c.maximum, t0, t1)...)
+		}
+	case math.Abs(b) >= utils.FloatDiffThreshold:
+		xs = append(xs, sideHits(c, r, c.minimum, c.maximum, -cc/(2*b))...)
+	}
+	if c.closed {
+		xs = append(xs, capHits(c, r, c.minimum, c.maximum, math.Abs)...)
+	}
+	return NewIntersections(xs...)
+}
+
+// LocalNormalAt returns the surface normal of cone c at object
+// space point p, which points away from the side of the cone
+// and along the y-axis on the caps
+func (c *cone) LocalNormalAt(p tuples.Point, _ Intersection) tuples.Normal {
+	dist := p.X*p.X + p.Z*p.Z
+	if dist < p.Y*p.Y && p.Y >= c.maximum-utils.FloatDiffThreshold {
+		return tuples.NewNormal(0, 1, 0)
+	}
+	if dist < p.Y*p.Y && p.Y <= c.minimum+utils.FloatDiffThreshold {
+		return tuples.NewNormal(0, -1, 0)
+	}
+	y := math.Sqrt(dist)
+	if p.Y > 0 {
+		y = -y
+	}
+	return tuples.NewNormal(p.X, y, p.Z)
+}
@@ -0,0 +1,68 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestConeIntersect casts rays at infinite and capped
+// cones and checks the intersections
+func TestConeIntersect(t *testing.T) {
+	inf := math.Inf(1)
+	testCases := []struct {
+		name  string
+		cone  Shape
+		r     rays.Ray
+		expTs []float64
+	}{
+		{"through the apex", NewCone(-inf, inf, false), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), []float64{5, 5}},
+		{"along the side", NewCone(-inf, inf, false), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(1, 1, 1)), []float64{5, 5}},
+		{"through both halves", NewCone(-inf, inf, false), rays.NewRay(tuples.NewPoint(1, 1, -5), tuples.NewVector(-0.5, -1, 1)), []float64{(9 - math.Sqrt(56)) / 0.5, (9 + math.Sqrt(56)) / 0.5}},
+		{"parallel to one half", NewCone(-inf, inf, false), rays.NewRay(tuples.NewPoint(0, 0, -1), tuples.NewVector(0, 1, 1)), []float64{0.25}},
+		{"miss the caps", NewCone(-0.5, 0.5, true), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 1, 0)), []float64{}},
+		{"through the side and a cap", NewCone(-0.5, 0.5, true), rays.NewRay(tuples.NewPoint(0, 0, -0.25), tuples.NewVector(0, 1, 1)), []float64{0.0625, 0.5}},
+		{"through both caps and sides", NewCone(-0.5, 0.5, true), rays.NewRay(tuples.NewPoint(0, 0, -0.25), tuples.NewVector(0, 1, 0)), []float64{-0.5, -0.25, 0.25, 0.5}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			xs := Intersect(testCase.cone, testCase.r)
+			if len(xs) != len(testCase.expTs) {
+				t.Fatalf("Expected %d intersections, but got %d: %s", len(testCase.expTs), len(xs), xs)
+			}
+			for i, expT := range testCase.expTs {
+				if !utils.FloatEqual(xs[i].T, expT) {
+					t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+				}
+			}
+		})
+	}
+}
+
+// TestConeNormalAt checks if normals point away from
+// the side, and along the axis on the caps
+func TestConeNormalAt(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cone      Shape
+		point     tuples.Point
+		expNormal tuples.Normal
+	}{
+		{"apex", NewCone(math.Inf(-1), math.Inf(1), false), tuples.NewPoint(0, 0, 0), tuples.NewNormal(0, 0, 0)},
+		{"upper half", NewCone(math.Inf(-1), math.Inf(1), false), tuples.NewPoint(1, 1, 1), tuples.NewNormal(1, -math.Sqrt2, 1)},
+		{"lower half", NewCone(math.Inf(-1), math.Inf(1), false), tuples.NewPoint(-1, -1, 0), tuples.NewNormal(-1, 1, 0)},
+		{"top cap", NewCone(-0.5, 0.5, true), tuples.NewPoint(0, 0.5, 0.2), tuples.NewNormal(0, 1, 0)},
+		{"bottom cap", NewCone(-0.5, 0.5, true), tuples.NewPoint(0.1, -0.5, 0), tuples.NewNormal(0, -1, 0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			normal := testCase.cone.LocalNormalAt(testCase.point, Intersection{})
+			if !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal at %s to be %s, but got %s", testCase.point, testCase.expNormal, normal)
+			}
+		})
+	}
+}
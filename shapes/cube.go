@@ -0,0 +1,75 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+type cube struct {
+	baseShape
+}
+
+// NewCube returns an axis-aligned cube spanning -1 to 1 in every axis,
+// with the identity transformation and the default material
+func NewCube() Shape {
+	return &cube{baseShape: newBaseShape()}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with cube c
+//
+// The cube is treated as the overlap of three slabs, one per axis.
+// The ray enters the cube at the last slab it enters, and leaves
+// it at the first slab it leaves. Either zero or two intersections
+// are returned, sorted in increasing order of t
+func (c *cube) LocalIntersect(r rays.Ray) Intersections {
+	xtmin, xtmax := checkAxis(r.Origin.X, r.Direction.X, -1, 1)
+	ytmin, ytmax := checkAxis(r.Origin.Y, r.Direction.Y, -1, 1)
+	ztmin, ztmax := checkAxis(r.Origin.Z, r.Direction.Z, -1, 1)
+	tmin := math.Max(xtmin, math.Max(ytmin, ztmin))
+	tmax := math.Min(xtmax, math.Min(ytmax, ztmax))
+	if tmin > tmax {
+		return Intersections{}
+	}
+	return NewIntersections(NewIntersection(tmin, c), NewIntersection(tmax, c))
+}
+
+// LocalNormalAt returns the surface normal of cube c at object space
+// point p, which is perpendicular to the face p lies on
+//
+// The face is the one along the axis of the largest absolute component of p
+func (c *cube) LocalNormalAt(p tuples.Point, _ Intersection) tuples.Normal {
+	absX, absY, absZ := math.Abs(p.X), math.Abs(p.Y), math.Abs(p.Z)
+	maxc := math.Max(absX, math.Max(absY, absZ))
+	switch maxc {
+	case absX:
+		return tuples.NewNormal(p.X, 0, 0)
+	case absY:
+		return tuples.NewNormal(0, p.Y, 0)
+	}
+	return tuples.NewNormal(0, 0, p.Z)
+}
+
+// checkAxis returns the values of t at which a ray with the given
+// origin and direction along one axis crosses min and max, in
+// increasing order
+//
+// Rays parallel to the axis are within the slab either everywhere
+// or nowhere, depending on their origin
+func checkAxis(origin, direction, min, max float64) (tmin, tmax float64) {
+	if math.Abs(direction) < utils.FloatDiffThreshold {
+		if origin < min || origin > max {
+			return math.Inf(1), math.Inf(-1)
+		}
+		return math.Inf(-1), math.Inf(1)
+	}
+	tmin = (min - origin) / direction
+	tmax = (max - origin) / direction
+	if tmin > tmax {
+		tmin, tmax = tmax, tmin
+	}
+	return tmin, tmax
+}
@@ -0,0 +1,78 @@
+package shapes
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestCubeIntersect casts rays at cubes from every side
+// and checks if the intersections are computed correctly
+func TestCubeIntersect(t *testing.T) {
+	testCases := []struct {
+		name  string
+		r     rays.Ray
+		expTs []float64
+	}{
+		{"+x", rays.NewRay(tuples.NewPoint(5, 0.5, 0), tuples.NewVector(-1, 0, 0)), []float64{4, 6}},
+		{"-x", rays.NewRay(tuples.NewPoint(-5, 0.5, 0), tuples.NewVector(1, 0, 0)), []float64{4, 6}},
+		{"+y", rays.NewRay(tuples.NewPoint(0.5, 5, 0), tuples.NewVector(0, -1, 0)), []float64{4, 6}},
+		{"-y", rays.NewRay(tuples.NewPoint(0.5, -5, 0), tuples.NewVector(0, 1, 0)), []float64{4, 6}},
+		{"+z", rays.NewRay(tuples.NewPoint(0.5, 0, 5), tuples.NewVector(0, 0, -1)), []float64{4, 6}},
+		{"-z", rays.NewRay(tuples.NewPoint(0.5, 0, -5), tuples.NewVector(0, 0, 1)), []float64{4, 6}},
+		{"inside", rays.NewRay(tuples.NewPoint(0, 0.5, 0), tuples.NewVector(0, 0, 1)), []float64{-1, 1}},
+		{"edge", rays.NewRay(tuples.NewPoint(-2, 1, 0), tuples.NewVector(1, 0, 0)), []float64{1, 3}},
+		{"miss diagonally", rays.NewRay(tuples.NewPoint(-2, 0, 0), tuples.NewVector(0.2673, 0.5345, 0.8018)), []float64{}},
+		{"miss diagonally from y", rays.NewRay(tuples.NewPoint(0, -2, 0), tuples.NewVector(0.8018, 0.2673, 0.5345)), []float64{}},
+		{"miss diagonally from z", rays.NewRay(tuples.NewPoint(0, 0, -2), tuples.NewVector(0.5345, 0.8018, 0.2673)), []float64{}},
+		{"miss parallel to x", rays.NewRay(tuples.NewPoint(2, 0, 2), tuples.NewVector(0, 0, -1)), []float64{}},
+		{"miss parallel to y", rays.NewRay(tuples.NewPoint(0, 2, 2), tuples.NewVector(0, -1, 0)), []float64{}},
+		{"miss parallel to z", rays.NewRay(tuples.NewPoint(2, 2, 0), tuples.NewVector(-1, 0, 0)), []float64{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := NewCube()
+			xs := Intersect(c, testCase.r)
+			if len(xs) != len(testCase.expTs) {
+				t.Fatalf("Expected %d intersections, but got %d: %s", len(testCase.expTs), len(xs), xs)
+			}
+			for i, expT := range testCase.expTs {
+				if !utils.FloatEqual(xs[i].T, expT) {
+					t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+				}
+				if xs[i].Object != c {
+					t.Fatalf("Expected intersection %d to be with the intersected cube", i)
+				}
+			}
+		})
+	}
+}
+
+// TestCubeNormalAt checks if normals point out of
+// the face, edge or corner a point lies on
+func TestCubeNormalAt(t *testing.T) {
+	testCases := []struct {
+		name      string
+		point     tuples.Point
+		expNormal tuples.Normal
+	}{
+		{"+x face", tuples.NewPoint(1, 0.5, -0.8), tuples.NewNormal(1, 0, 0)},
+		{"-x face", tuples.NewPoint(-1, -0.2, 0.9), tuples.NewNormal(-1, 0, 0)},
+		{"+y face", tuples.NewPoint(-0.4, 1, -0.1), tuples.NewNormal(0, 1, 0)},
+		{"-y face", tuples.NewPoint(0.3, -1, -0.7), tuples.NewNormal(0, -1, 0)},
+		{"+z face", tuples.NewPoint(-0.6, 0.3, 1), tuples.NewNormal(0, 0, 1)},
+		{"-z face", tuples.NewPoint(0.4, 0.4, -1), tuples.NewNormal(0, 0, -1)},
+		{"+x+y+z corner", tuples.NewPoint(1, 1, 1), tuples.NewNormal(1, 0, 0)},
+		{"-x-y-z corner", tuples.NewPoint(-1, -1, -1), tuples.NewNormal(-1, 0, 0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			normal := NewCube().LocalNormalAt(testCase.point, Intersection{})
+			if !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal at %s to be %s, but got %s", testCase.point, testCase.expNormal, normal)
+			}
+		})
+	}
+}
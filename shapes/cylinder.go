@@ -0,0 +1,97 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+type cylinder struct {
+	baseShape
+	minimum, maximum float64
+	closed           bool
+}
+
+// NewCylinder returns a cylinder of radius 1 around the y-axis,
+// truncated at y=minimum and y=maximum (both excluded), with the
+// identity transformation and the default material
+//
+// If closed is set, the ends are capped. Pass math.Inf(-1) and
+// math.Inf(1) for an infinitely long cylinder
+func NewCylinder(minimum, maximum float64, closed bool) Shape {
+	return &cylinder{baseShape: newBaseShape(), minimum: minimum, maximum: maximum, closed: closed}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with cylinder c, sorted in increasing order of t
+//
+// Rays parallel to the y-axis can only hit the caps
+func (c *cylinder) LocalIntersect(r rays.Ray) Intersections {
+	xs := Intersections{}
+	a := r.Direction.X*r.Direction.X + r.Direction.Z*r.Direction.Z
+	if math.Abs(a) >= utils.FloatDiffThreshold {
+		b := 2 * (r.Origin.X*r.Direction.X + r.Origin.Z*r.Direction.Z)
+		cc := r.Origin.X*r.Origin.X + r.Origin.Z*r.Origin.Z - 1
+		discriminant := b*b - 4*a*cc
+		if discriminant >= 0 {
+			t0 := (-b - math.Sqrt(discriminant)) / (2 * a)
+			t1 := (-b + math.Sqrt(discriminant)) / (2 * a)
+			xs = append(xs, sideHits(c, r, c.minimum, c.maximum, t0, t1)...)
+		}
+	}
+	if c.closed {
+		xs = append(xs, capHits(c, r, c.minimum, c.maximum, func(float64) float64 { return 1 })...)
+	}
+	return NewIntersections(xs...)
+}
+
+// LocalNormalAt returns the surface normal of cylinder c at object
+// space point p, which points away from the y-axis on the side
+// and along the y-axis on the caps
+func (c *cylinder) LocalNormalAt(p tuples.Point, _ Intersection) tuples.Normal {
+	dist := p.X*p.X + p.Z*p.Z
+	if dist < 1 && p.Y >= c.maximum-utils.FloatDiffThreshold {
+		return tuples.NewNormal(0, 1, 0)
+	}
+	if dist < 1 && p.Y <= c.minimum+utils.FloatDiffThreshold {
+		return tuples.NewNormal(0, -1, 0)
+	}
+	return tuples.NewNormal(p.X, 0, p.Z)
+}
+
+// sideHits returns the intersections at the given values of t along
+// ray r with the side of shape s, that lie strictly between
+// y=minimum and y=maximum
+func sideHits(s Shape, r rays.Ray, minimum, maximum float64, ts ...float64) Intersections {
+	xs := Intersections{}
+	for _, t := range ts {
+		if y := r.Origin.Y + t*r.Direction.Y; minimum < y && y < maximum {
+			xs = append(xs, NewIntersection(t, s))
+		}
+	}
+	return xs
+}
+
+// capHits returns the intersections of ray r with the caps at
+// y=minimum and y=maximum of shape s, whose radius at height y
+// is given by radius. Infinite ends have no cap
+func capHits(s Shape, r rays.Ray, minimum, maximum float64, radius func(y float64) float64) Intersections {
+	xs := Intersections{}
+	if math.Abs(r.Direction.Y) < utils.FloatDiffThreshold {
+		return xs
+	}
+	for _, y := range []float64{minimum, maximum} {
+		if math.IsInf(y, 0) {
+			continue
+		}
+		t := (y - r.Origin.Y) / r.Direction.Y
+		x := r.Origin.X + t*r.Direction.X
+		z := r.Origin.Z + t*r.Direction.Z
+		if rad := radius(y); x*x+z*z <= rad*rad {
+			xs = append(xs, NewIntersection(t, s))
+		}
+	}
+	return xs
+}
@@ -0,0 +1,80 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestCylinderIntersect casts rays at infinite, truncated
+// and capped cylinders and checks the intersections
+func TestCylinderIntersect(t *testing.T) {
+	inf := math.Inf(1)
+	testCases := []struct {
+		name     string
+		cylinder Shape
+		r        rays.Ray
+		expTs    []float64
+	}{
+		{"miss to the side", NewCylinder(-inf, inf, false), rays.NewRay(tuples.NewPoint(1, 0, 0), tuples.NewVector(0, 1, 0)), []float64{}},
+		{"miss inside, parallel to the axis", NewCylinder(-inf, inf, false), rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0)), []float64{}},
+		{"miss diagonally", NewCylinder(-inf, inf, false), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(1, 1, 1)), []float64{}},
+		{"tangent", NewCylinder(-inf, inf, false), rays.NewRay(tuples.NewPoint(1, 0, -5), tuples.NewVector(0, 0, 1)), []float64{5, 5}},
+		{"through the axis", NewCylinder(-inf, inf, false), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), []float64{4, 6}},
+		{"at an angle", NewCylinder(-inf, inf, false), rays.NewRay(tuples.NewPoint(0.5, 0, -5), tuples.NewVector(0.1, 1, 1)), []float64{9.7 / 2.02, 10.1 / 2.02}},
+		{"escapes through the open top", NewCylinder(1, 2, false), rays.NewRay(tuples.NewPoint(0, 1.5, 0), tuples.NewVector(0.1, 1, 0)), []float64{}},
+		{"above the truncation", NewCylinder(1, 2, false), rays.NewRay(tuples.NewPoint(0, 3, -5), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"below the truncation", NewCylinder(1, 2, false), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"at the maximum", NewCylinder(1, 2, false), rays.NewRay(tuples.NewPoint(0, 2, -5), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"at the minimum", NewCylinder(1, 2, false), rays.NewRay(tuples.NewPoint(0, 1, -5), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"within the truncation", NewCylinder(1, 2, false), rays.NewRay(tuples.NewPoint(0, 1.5, -2), tuples.NewVector(0, 0, 1)), []float64{1, 3}},
+		{"through both caps", NewCylinder(1, 2, true), rays.NewRay(tuples.NewPoint(0, 3, 0), tuples.NewVector(0, -1, 0)), []float64{1, 2}},
+		{"through the top cap and side", NewCylinder(1, 2, true), rays.NewRay(tuples.NewPoint(0, 3, -2), tuples.NewVector(0, -1, 2)), []float64{1, 1.5}},
+		{"through the corner", NewCylinder(1, 2, true), rays.NewRay(tuples.NewPoint(0, 4, -2), tuples.NewVector(0, -1, 1)), []float64{2, 3}},
+		{"through the bottom cap and side", NewCylinder(1, 2, true), rays.NewRay(tuples.NewPoint(0, 0, -2), tuples.NewVector(0, 1, 2)), []float64{1, 1.5}},
+		{"capped infinite cylinder", NewCylinder(-inf, inf, true), rays.NewRay(tuples.NewPoint(0, 3, 0), tuples.NewVector(0, -1, 0)), []float64{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			xs := Intersect(testCase.cylinder, testCase.r)
+			if len(xs) != len(testCase.expTs) {
+				t.Fatalf("Expected %d intersections, but got %d: %s", len(testCase.expTs), len(xs), xs)
+			}
+			for i, expT := range testCase.expTs {
+				if !utils.FloatEqual(xs[i].T, expT) {
+					t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+				}
+			}
+		})
+	}
+}
+
+// TestCylinderNormalAt checks if normals point away from
+// the axis on the side, and along it on the caps
+func TestCylinderNormalAt(t *testing.T) {
+	testCases := []struct {
+		name      string
+		point     tuples.Point
+		expNormal tuples.Normal
+	}{
+		{"+x side", tuples.NewPoint(1, 0, 0), tuples.NewNormal(1, 0, 0)},
+		{"-z side", tuples.NewPoint(0, 5, -1), tuples.NewNormal(0, 0, -1)},
+		{"+z side", tuples.NewPoint(0, -2, 1), tuples.NewNormal(0, 0, 1)},
+		{"-x side", tuples.NewPoint(-1, 1.5, 0), tuples.NewNormal(-1, 0, 0)},
+		{"bottom cap center", tuples.NewPoint(0, 1, 0), tuples.NewNormal(0, -1, 0)},
+		{"bottom cap", tuples.NewPoint(0.5, 1, 0), tuples.NewNormal(0, -1, 0)},
+		{"top cap center", tuples.NewPoint(0, 2, 0), tuples.NewNormal(0, 1, 0)},
+		{"top cap", tuples.NewPoint(0, 2, 0.5), tuples.NewNormal(0, 1, 0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			normal := NewCylinder(1, 2, true).LocalNormalAt(testCase.point, Intersection{})
+			if !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal at %s to be %s, but got %s", testCase.point, testCase.expNormal, normal)
+			}
+		})
+	}
+}
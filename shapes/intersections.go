@@ -0,0 +1,74 @@
+package shapes
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Intersection is the point at distance T along a ray where it hits Object
+//
+// U and V locate the hit on the surface of triangles,
+// relative to their vertices, and are zero otherwise
+type Intersection struct {
+	T      float64
+	Object Shape
+	U, V   float64
+}
+
+type Intersections []Intersection
+
+// NewIntersection returns a new Intersection of object
+// at distance t along a ray
+func NewIntersection(t float64, object Shape) Intersection {
+	return Intersection{T: t, Object: object}
+}
+
+// NewIntersectionWithUV returns a new Intersection of object at
+// distance t along a ray, at surface coordinates u and v
+func NewIntersectionWithUV(t float64, object Shape, u, v float64) Intersection {
+	return Intersection{T: t, Object: object, U: u, V: v}
+}
+
+// NewIntersections returns the given intersections
+// sorted in increasing order of t
+func NewIntersections(xs ...Intersection) Intersections {
+	intersections := Intersections(xs)
+	intersections.Sort()
+	return intersections
+}
+
+// String returns the string representation of i
+func (i Intersection) String() string {
+	return fmt.Sprintf("t=%.3f", i.T)
+}
+
+// String returns the string representation of xs
+func (xs Intersections) String() string {
+	s := make([]string, len(xs))
+	for i, x := range xs {
+		s[i] = x.String()
+	}
+	return "[" + strings.Join(s, " ") + "]"
+}
+
+// Sort sorts xs in place in increasing order of t
+func (xs Intersections) Sort() {
+	sort.SliceStable(xs, func(i, j int) bool {
+		return xs[i].T < xs[j].T
+	})
+}
+
+// Hit returns the visible intersection in xs,
+// i.e, the intersection with the lowest non-negative t
+//
+// xs is expected to be sorted. The second return value
+// reports whether such an intersection exists
+func (xs Intersections) Hit() (Intersection, bool) {
+	for _, x := range xs {
+		if x.T >= 0 {
+			return x, true
+		}
+	}
+	return Intersection{}, false
+}
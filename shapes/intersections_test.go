@@ -0,0 +1,63 @@
+package shapes
+
+import (
+	"testing"
+)
+
+// TestNewIntersections checks if intersections
+// are sorted in increasing order of t
+func TestNewIntersections(t *testing.T) {
+	s := NewSphere()
+	xs := NewIntersections(
+		NewIntersection(5, s),
+		NewIntersection(7, s),
+		NewIntersection(-3, s),
+		NewIntersection(2, s),
+	)
+	expTs := []float64{-3, 2, 5, 7}
+	if len(xs) != len(expTs) {
+		t.Fatalf("Expected %d intersections, but got %d", len(expTs), len(xs))
+	}
+	for i, expT := range expTs {
+		if xs[i].T != expT {
+			t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+		}
+		if xs[i].Object != s {
+			t.Fatalf("Expected intersection %d to keep its object", i)
+		}
+	}
+}
+
+// TestHit checks if the lowest non-negative
+// intersection is picked as the hit
+func TestHit(t *testing.T) {
+	s := NewSphere()
+	testCases := []struct {
+		name   string
+		ts     []float64
+		expHit bool
+		expT   float64
+	}{
+		{"all positive", []float64{1, 2}, true, 1},
+		{"some negative", []float64{-1, 1}, true, 1},
+		{"all negative", []float64{-2, -1}, false, 0},
+		{"unordered input", []float64{5, 7, -3, 2}, true, 2},
+		{"zero t", []float64{0, 3}, true, 0},
+		{"no intersections", []float64{}, false, 0},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			xs := make([]Intersection, len(testCase.ts))
+			for i, tVal := range testCase.ts {
+				xs[i] = NewIntersection(tVal, s)
+			}
+			hit, ok := NewIntersections(xs...).Hit()
+			if ok != testCase.expHit {
+				t.Fatalf("Expected hit to exist: %t, but got: %t", testCase.expHit, ok)
+			}
+			if ok && hit.T != testCase.expT {
+				t.Fatalf("Expected hit at t=%f, but got %f", testCase.expT, hit.T)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+type plane struct {
+	baseShape
+}
+
+// NewPlane returns an infinite plane spanning the x- and z- axes
+// with the identity transformation and the default material
+func NewPlane() Shape {
+	return &plane{baseShape: newBaseShape()}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with plane p
+//
+// Rays parallel to the plane, including those
+// lying within it, never hit it
+func (p *plane) LocalIntersect(r rays.Ray) Intersections {
+	if math.Abs(r.Direction.Y) < utils.FloatDiffThreshold {
+		return Intersections{}
+	}
+	return NewIntersections(NewIntersection(-r.Origin.Y/r.Direction.Y, p))
+}
+
+// LocalNormalAt returns the surface normal of plane p,
+// which points along the y-axis everywhere
+func (p *plane) LocalNormalAt(tuples.Point, Intersection) tuples.Normal {
+	return tuples.NewNormal(0, 1, 0)
+}
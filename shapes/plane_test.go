@@ -0,0 +1,80 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestPlaneIntersect casts rays at planes and checks
+// if the intersections are computed correctly
+func TestPlaneIntersect(t *testing.T) {
+	testCases := []struct {
+		name      string
+		r         rays.Ray
+		transform matrices.Transformation
+		expTs     []float64
+	}{
+		{"parallel ray", rays.NewRay(tuples.NewPoint(0, 10, 0), tuples.NewVector(0, 0, 1)), nil, []float64{}},
+		{"coplanar ray", rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1)), nil, []float64{}},
+		{"ray from above", rays.NewRay(tuples.NewPoint(0, 1, 0), tuples.NewVector(0, -1, 0)), nil, []float64{1}},
+		{"ray from below", rays.NewRay(tuples.NewPoint(0, -1, 0), tuples.NewVector(0, 1, 0)), nil, []float64{1}},
+		{"plane behind ray", rays.NewRay(tuples.NewPoint(0, 2, 0), tuples.NewVector(0, 1, 0)), nil, []float64{-2}},
+		{"oblique ray", rays.NewRay(tuples.NewPoint(3, 4, 5), tuples.NewVector(1, -2, 0)), nil, []float64{2}},
+		{"translated plane", rays.NewRay(tuples.NewPoint(0, 5, 0), tuples.NewVector(0, -1, 0)), matrices.NewTranslation(0, 2, 0), []float64{3}},
+		{"wall", rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), matrices.NewRotationX(math.Pi / 2), []float64{5}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := NewPlane()
+			if testCase.transform != nil {
+				p.SetTransform(testCase.transform)
+			}
+			xs := Intersect(p, testCase.r)
+			if len(xs) != len(testCase.expTs) {
+				t.Fatalf("Expected %d intersections, but got %d: %s", len(testCase.expTs), len(xs), xs)
+			}
+			for i, expT := range testCase.expTs {
+				if !utils.FloatEqual(xs[i].T, expT) {
+					t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+				}
+				if xs[i].Object != p {
+					t.Fatalf("Expected intersection %d to be with the intersected plane", i)
+				}
+			}
+		})
+	}
+}
+
+// TestPlaneNormalAt checks if planes have the same normal
+// everywhere, following their transformation
+func TestPlaneNormalAt(t *testing.T) {
+	testCases := []struct {
+		name      string
+		point     tuples.Point
+		transform matrices.Transformation
+		expNormal tuples.Vector
+	}{
+		{"origin", tuples.NewPoint(0, 0, 0), nil, tuples.NewVector(0, 1, 0)},
+		{"far away", tuples.NewPoint(10, 0, -10), nil, tuples.NewVector(0, 1, 0)},
+		{"translated plane", tuples.NewPoint(-5, 3, 150), matrices.NewTranslation(0, 3, 0), tuples.NewVector(0, 1, 0)},
+		{"scaled plane", tuples.NewPoint(1, 0, 1), matrices.NewScaling(2, 0.5, 2), tuples.NewVector(0, 1, 0)},
+		{"wall", tuples.NewPoint(1, 1, 0), matrices.NewRotationX(math.Pi / 2), tuples.NewVector(0, 0, 1)},
+		{"tilted plane", tuples.NewPoint(0, 0, 0), matrices.NewRotationZ(math.Pi / 4), tuples.NewVector(-math.Sqrt2/2, math.Sqrt2/2, 0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			p := NewPlane()
+			if testCase.transform != nil {
+				p.SetTransform(testCase.transform)
+			}
+			if normal := NormalAt(p, testCase.point, Intersection{}); !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal at %s to be %s, but got %s", testCase.point, testCase.expNormal, normal)
+			}
+		})
+	}
+}
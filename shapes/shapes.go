@@ -0,0 +1,134 @@
+// package shapes provides primitives that can be placed in a scene,
+// along with methods to intersect them with rays
+package shapes
+
+import (
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/patterns"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// Shape is implemented by every primitive that can be placed in a scene
+//
+// Primitives only describe themselves in object space through
+// LocalIntersect and LocalNormalAt. Intersect and NormalAt take care
+// of moving rays, points and normals between world and object space
+type Shape interface {
+	Transform() matrices.Transformation
+	SetTransform(matrices.Transformation) error
+	Material() materials.Material
+	SetMaterial(materials.Material)
+	LocalIntersect(rays.Ray) Intersections
+	LocalNormalAt(tuples.Point, Intersection) tuples.Normal
+	Parent() Shape
+	SetParent(Shape)
+}
+
+// baseShape holds the state shared by every primitive,
+// and is meant to be embedded in their structs
+type baseShape struct {
+	transform matrices.Transformation
+	material  materials.Material
+	parent    Shape
+}
+
+// newBaseShape returns a baseShape with the identity
+// transformation, the default material and no parent
+func newBaseShape() baseShape {
+	return baseShape{
+		transform: matrices.NewIdentityTransformation(),
+		material:  materials.NewMaterial(),
+	}
+}
+
+// Transform returns the transformation applied to shape s
+func (s *baseShape) Transform() matrices.Transformation {
+	return s.transform
+}
+
+// SetTransform sets the transformation applied to shape s
+//
+// It returns an error and leaves the transformation unchanged
+// if t is not invertible, since rays could not be moved into object space
+func (s *baseShape) SetTransform(t matrices.Transformation) error {
+	if !t.IsInvertible() {
+		return matrices.ErrMatrixNotInvertible
+	}
+	s.transform = t
+	return nil
+}
+
+// Material returns the material shape s is made of
+func (s *baseShape) Material() materials.Material {
+	return s.material
+}
+
+// SetMaterial sets the material shape s is made of
+func (s *baseShape) SetMaterial(m materials.Material) {
+	s.material = m
+}
+
+// Parent returns the shape containing shape s,
+// or nil if s is placed directly in the scene
+func (s *baseShape) Parent() Shape {
+	return s.parent
+}
+
+// SetParent sets the shape containing shape s
+func (s *baseShape) SetParent(parent Shape) {
+	s.parent = parent
+}
+
+// Intersect returns the intersections of ray r with shape s
+//
+// The ray is moved into object space using the inverse of the
+// transformation of s, before being handed to s.LocalIntersect.
+// Rays passed down from a parent are expected to be in the
+// parent's object space already
+func Intersect(s Shape, r rays.Ray) Intersections {
+	return s.LocalIntersect(r.Transform(s.Transform().Inverse()))
+}
+
+// NormalAt returns the unit surface normal of shape s at worldPoint,
+// where ray intersection hit occurred
+//
+// The point is moved into object space, the normal is computed
+// by s.LocalNormalAt and moved back into world space. Only shapes
+// interpolating their normals, such as smooth triangles, use hit
+func NormalAt(s Shape, worldPoint tuples.Point, hit Intersection) tuples.Vector {
+	return NormalToWorld(s, s.LocalNormalAt(WorldToObject(s, worldPoint), hit))
+}
+
+// WorldToObject moves worldPoint into the object space of shape s,
+// going through the object space of every parent of s
+func WorldToObject(s Shape, worldPoint tuples.Point) tuples.Point {
+	if parent := s.Parent(); parent != nil {
+		worldPoint = WorldToObject(parent, worldPoint)
+	}
+	return matrices.Transform(worldPoint, s.Transform().Inverse())
+}
+
+// NormalToWorld moves normal n from the object space of shape s
+// into world space, going through the object space of every parent of s
+//
+// The inverse-transpose is used so that n stays perpendicular
+// to the surface under non-uniform scaling.
+// The returned normal is normalized
+func NormalToWorld(s Shape, n tuples.Normal) tuples.Vector {
+	n, _ = matrices.TransformNormal(n, s.Transform()).Normalized()
+	if parent := s.Parent(); parent != nil {
+		return NormalToWorld(parent, n)
+	}
+	return n.Vector()
+}
+
+// PatternAt returns the color of pattern p applied to shape s at worldPoint
+//
+// The point is moved into the object space of s first,
+// and then into the space of the pattern
+func PatternAt(p patterns.Pattern, s Shape, worldPoint tuples.Point) canvas.Color {
+	return patterns.ColorAtObject(p, WorldToObject(s, worldPoint))
+}
@@ -0,0 +1,200 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/patterns"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+// testShape is a Shape that records the last object space
+// ray it was intersected with, to check the conversions
+// shared by every primitive
+type testShape struct {
+	baseShape
+	savedRay rays.Ray
+}
+
+func newTestShape() *testShape {
+	return &testShape{baseShape: newBaseShape()}
+}
+
+func (s *testShape) LocalIntersect(r rays.Ray) Intersections {
+	s.savedRay = r
+	return Intersections{}
+}
+
+// LocalNormalAt returns the object space point as a normal,
+// so that the conversion of points can be observed as well
+func (s *testShape) LocalNormalAt(p tuples.Point, _ Intersection) tuples.Normal {
+	return tuples.NewNormal(p.X, p.Y, p.Z)
+}
+
+// TestShapeDefaults checks if shapes start with the identity
+// transformation, the default material and no parent,
+// and that all of them can be changed
+func TestShapeDefaults(t *testing.T) {
+	s := newTestShape()
+	iden := matrices.NewIdentityTransformation()
+	if !s.Transform().Mat4().IsEqualTo(iden.Mat4()) {
+		t.Fatalf("Expected default shape transform to be\n%s\nbut got\n%s", iden, s.Transform())
+	}
+	if !s.Material().IsEqualTo(materials.NewMaterial()) {
+		t.Fatalf("Expected default shape material to be %s, but got %s", materials.NewMaterial(), s.Material())
+	}
+	if s.Parent() != nil {
+		t.Fatalf("Expected default shape to have no parent")
+	}
+
+	translation := matrices.NewTranslation(2, 3, 4)
+	if err := s.SetTransform(translation); err != nil {
+		t.Fatalf("Expected no error but got %v", err)
+	}
+	if !s.Transform().Mat4().IsEqualTo(translation.Mat4()) {
+		t.Fatalf("Expected shape transform to be\n%s\nbut got\n%s", translation, s.Transform())
+	}
+	m := materials.NewMaterial()
+	m.Ambient = 1
+	s.SetMaterial(m)
+	if !s.Material().IsEqualTo(m) {
+		t.Fatalf("Expected shape material to be %s, but got %s", m, s.Material())
+	}
+	parent := newTestShape()
+	s.SetParent(parent)
+	if s.Parent() != parent {
+		t.Fatalf("Expected shape parent to be set")
+	}
+}
+
+// TestSetNonInvertibleTransform checks if a shape rejects
+// a transformation that cannot be inverted and keeps its own
+func TestSetNonInvertibleTransform(t *testing.T) {
+	s := newTestShape()
+	translation := matrices.NewTranslation(1, 2, 3)
+	s.SetTransform(translation)
+	if err := s.SetTransform(matrices.NewScaling(0, 1, 1)); err != matrices.ErrMatrixNotInvertible {
+		t.Fatalf("Expected %v but got %v", matrices.ErrMatrixNotInvertible, err)
+	}
+	if !s.Transform().Mat4().IsEqualTo(translation.Mat4()) {
+		t.Fatalf("Expected shape transform to stay\n%s\nbut got\n%s", translation, s.Transform())
+	}
+}
+
+// TestIntersect checks if rays are moved into
+// object space before the local intersection
+func TestIntersect(t *testing.T) {
+	testCases := []struct {
+		name      string
+		transform matrices.Transformation
+		expRay    rays.Ray
+	}{
+		{"identity", matrices.NewIdentityTransformation(), rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))},
+		{"scaled shape", matrices.NewScaling(2, 2, 2), rays.NewRay(tuples.NewPoint(0, 0, -2.5), tuples.NewVector(0, 0, 0.5))},
+		{"translated shape", matrices.NewTranslation(5, 0, 0), rays.NewRay(tuples.NewPoint(-5, 0, -5), tuples.NewVector(0, 0, 1))},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := newTestShape()
+			s.SetTransform(testCase.transform)
+			Intersect(s, rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)))
+			if !s.savedRay.IsEqualTo(testCase.expRay) {
+				t.Fatalf("Expected local ray to be %s but got %s", testCase.expRay, s.savedRay)
+			}
+		})
+	}
+}
+
+// TestNormalAt checks if points are moved into object space,
+// and normals back into world space around the local normal
+func TestNormalAt(t *testing.T) {
+	k := math.Sqrt2 / 2
+	rotated, _ := matrices.NewTransformChain().RotateZ(math.Pi/5).Scale(1, 0.5, 1).Build()
+	testCases := []struct {
+		name      string
+		transform matrices.Transformation
+		point     tuples.Point
+		expNormal tuples.Vector
+	}{
+		{"translated shape", matrices.NewTranslation(0, 1, 0), tuples.NewPoint(0, 1.70711, -0.70711), tuples.NewVector(0, 0.707107, -0.707107)},
+		{"transformed shape", rotated, tuples.NewPoint(0, k, -k), tuples.NewVector(0, 0.970143, -0.242536)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := newTestShape()
+			s.SetTransform(testCase.transform)
+			if normal := NormalAt(s, testCase.point, Intersection{}); !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal at %s to be %s, but got %s", testCase.point, testCase.expNormal, normal)
+			}
+		})
+	}
+}
+
+// TestParentConversions checks if conversions between world and
+// object space go through the transformations of every parent
+func TestParentConversions(t *testing.T) {
+	outer := newTestShape()
+	outer.SetTransform(matrices.NewRotationY(math.Pi / 2))
+	inner := newTestShape()
+	inner.SetTransform(matrices.NewScaling(2, 2, 2))
+	inner.SetParent(outer)
+	s := newTestShape()
+	s.SetTransform(matrices.NewTranslation(5, 0, 0))
+	s.SetParent(inner)
+
+	point := WorldToObject(s, tuples.NewPoint(-2, 0, -10))
+	expPoint := tuples.NewPoint(0, 0, -1)
+	if !point.IsEqualTo(expPoint) {
+		t.Fatalf("Expected object space point to be %s but got %s", expPoint, point)
+	}
+
+	k := math.Sqrt(3) / 3
+	point = matrices.Transform(tuples.NewPoint(k, k, k), s.Transform(), inner.Transform(), outer.Transform())
+	normal := NormalAt(s, point, Intersection{})
+	expNormal := tuples.NewVector(k, k, -k)
+	if !normal.IsEqualTo(expNormal) {
+		t.Fatalf("Expected normal at %s to be %s but got %s", point, expNormal, normal)
+	}
+
+	s.SetTransform(matrices.NewScaling(1, 2, 3))
+	normal = NormalToWorld(s, tuples.NewNormal(k, k, k))
+	expNormal = tuples.NewVector(2/math.Sqrt(49), 3/math.Sqrt(49), -6/math.Sqrt(49))
+	if !normal.IsEqualTo(expNormal) {
+		t.Fatalf("Expected world normal to be %s but got %s", expNormal, normal)
+	}
+}
+
+// TestPatternAt checks if patterns are applied after the
+// transformation of the shape they color
+func TestPatternAt(t *testing.T) {
+	white := canvas.Color{R: 1, G: 1, B: 1}
+	black := canvas.Color{}
+	testCases := []struct {
+		name             string
+		objectTransform  matrices.Transformation
+		patternTransform matrices.Transformation
+		point            tuples.Point
+		expColor         canvas.Color
+	}{
+		{"untransformed", matrices.NewIdentityTransformation(), matrices.NewIdentityTransformation(), tuples.NewPoint(1.5, 0, 0), black},
+		{"object transformation", matrices.NewScaling(2, 2, 2), matrices.NewIdentityTransformation(), tuples.NewPoint(1.5, 0, 0), white},
+		{"pattern transformation", matrices.NewIdentityTransformation(), matrices.NewScaling(2, 2, 2), tuples.NewPoint(1.5, 0, 0), white},
+		{"both transformations", matrices.NewScaling(2, 2, 2), matrices.NewTranslation(0.5, 0, 0), tuples.NewPoint(2.5, 0, 0), white},
+		{"both transformations, next stripe", matrices.NewScaling(2, 2, 2), matrices.NewTranslation(0.5, 0, 0), tuples.NewPoint(3.5, 0, 0), black},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := newTestShape()
+			s.SetTransform(testCase.objectTransform)
+			p := patterns.NewStripePattern(white, black)
+			p.SetTransform(testCase.patternTransform)
+			if color := PatternAt(p, s, testCase.point); !color.IsEqualTo(testCase.expColor) {
+				t.Fatalf("Expected color at %s to be %s but got %s", testCase.point, testCase.expColor, color)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package shapes
+
+import (
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type smoothTriangle struct {
+	baseShape
+	triangleGeometry
+	n1, n2, n3 tuples.Normal
+}
+
+// NewSmoothTriangle returns a triangle with vertices p1, p2 and p3,
+// with the identity transformation and the default material,
+// whose normal is interpolated between the vertex normals n1, n2 and n3
+//
+// It is used to approximate curved surfaces with meshes
+func NewSmoothTriangle(p1, p2, p3 tuples.Point, n1, n2, n3 tuples.Normal) Shape {
+	return &smoothTriangle{
+		baseShape:        newBaseShape(),
+		triangleGeometry: newTriangleGeometry(p1, p2, p3),
+		n1:               n1,
+		n2:               n2,
+		n3:               n3,
+	}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with smooth triangle tr
+func (tr *smoothTriangle) LocalIntersect(r rays.Ray) Intersections {
+	return tr.intersect(tr, r)
+}
+
+// LocalNormalAt returns the surface normal of smooth triangle tr
+// at the intersection hit, blending the vertex normals
+// with the barycentric coordinates of the hit
+func (tr *smoothTriangle) LocalNormalAt(_ tuples.Point, hit Intersection) tuples.Normal {
+	n := tr.n2.Vector().Multiply(hit.U).
+		Add(tr.n3.Vector().Multiply(hit.V)).
+		Add(tr.n1.Vector().Multiply(1 - hit.U - hit.V))
+	return tuples.NewNormal(n.X, n.Y, n.Z)
+}
@@ -0,0 +1,57 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// newTestSmoothTriangle returns the smooth triangle
+// shared by the smooth triangle tests
+func newTestSmoothTriangle() Shape {
+	return NewSmoothTriangle(
+		tuples.NewPoint(0, 1, 0), tuples.NewPoint(-1, 0, 0), tuples.NewPoint(1, 0, 0),
+		tuples.NewNormal(0, 1, 0), tuples.NewNormal(-1, 0, 0), tuples.NewNormal(1, 0, 0),
+	)
+}
+
+// TestSmoothTriangleIntersect checks if intersections with
+// smooth triangles record the barycentric coordinates of the hit
+func TestSmoothTriangleIntersect(t *testing.T) {
+	tr := newTestSmoothTriangle()
+	r := rays.NewRay(tuples.NewPoint(-0.2, 0.3, -2), tuples.NewVector(0, 0, 1))
+	xs := Intersect(tr, r)
+	if len(xs) != 1 {
+		t.Fatalf("Expected 1 intersection, but got %d: %s", len(xs), xs)
+	}
+	if !utils.FloatEqual(xs[0].U, 0.45) || !utils.FloatEqual(xs[0].V, 0.25) {
+		t.Fatalf("Expected u,v to be 0.45,0.25 but got %f,%f", xs[0].U, xs[0].V)
+	}
+}
+
+// TestSmoothTriangleNormalAt checks if normals are interpolated
+// between the vertex normals
+func TestSmoothTriangleNormalAt(t *testing.T) {
+	testCases := []struct {
+		name      string
+		u, v      float64
+		expNormal tuples.Vector
+	}{
+		{"at p1", 0, 0, tuples.NewVector(0, 1, 0)},
+		{"at p2", 1, 0, tuples.NewVector(-1, 0, 0)},
+		{"at p3", 0, 1, tuples.NewVector(1, 0, 0)},
+		{"inside", 0.45, 0.25, tuples.NewVector(-2/math.Sqrt(13), 3/math.Sqrt(13), 0)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tr := newTestSmoothTriangle()
+			hit := NewIntersectionWithUV(1, tr, testCase.u, testCase.v)
+			if normal := NormalAt(tr, tuples.NewPoint(0, 0, 0), hit); !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal to be %s, but got %s", testCase.expNormal, normal)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type sphere struct {
+	baseShape
+}
+
+// NewSphere returns a unit sphere centered at the origin
+// with the identity transformation and the default material
+func NewSphere() Shape {
+	return &sphere{baseShape: newBaseShape()}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with sphere s
+//
+// Either zero or two intersections are returned,
+// sorted in increasing order of t
+func (s *sphere) LocalIntersect(r rays.Ray) Intersections {
+	sphereToRay := r.Origin.Subtract(tuples.NewPoint(0, 0, 0))
+	a := r.Direction.Dot(r.Direction)
+	b := 2 * r.Direction.Dot(sphereToRay)
+	c := sphereToRay.Dot(sphereToRay) - 1
+	discriminant := b*b - 4*a*c
+	if discriminant < 0 {
+		return Intersections{}
+	}
+	t1 := (-b - math.Sqrt(discriminant)) / (2 * a)
+	t2 := (-b + math.Sqrt(discriminant)) / (2 * a)
+	return NewIntersections(NewIntersection(t1, s), NewIntersection(t2, s))
+}
+
+// LocalNormalAt returns the surface normal of sphere s
+// at object space point p, which points away from the center
+func (s *sphere) LocalNormalAt(p tuples.Point, _ Intersection) tuples.Normal {
+	return tuples.NewNormal(p.X, p.Y, p.Z)
+}
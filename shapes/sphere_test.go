@@ -0,0 +1,129 @@
+package shapes
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestSphereTransform checks if a sphere starts with
+// the identity transformation, and that it can be changed
+func TestSphereTransform(t *testing.T) {
+	s := NewSphere()
+	iden := matrices.NewIdentityTransformation()
+	if !s.Transform().Operator().IsEqualTo(iden.Operator()) {
+		t.Fatalf("Expected default sphere transform to be\n%s\nbut got\n%s", iden, s.Transform())
+	}
+	translation := matrices.NewTranslation(2, 3, 4)
+	s.SetTransform(translation)
+	if !s.Transform().Operator().IsEqualTo(translation.Operator()) {
+		t.Fatalf("Expected sphere transform to be\n%s\nbut got\n%s", translation, s.Transform())
+	}
+}
+
+// TestSphereMaterial checks if a sphere starts with
+// the default material, and that it can be changed
+func TestSphereMaterial(t *testing.T) {
+	s := NewSphere()
+	if !s.Material().IsEqualTo(materials.NewMaterial()) {
+		t.Fatalf("Expected default sphere material to be %s, but got %s", materials.NewMaterial(), s.Material())
+	}
+	m := materials.NewMaterial()
+	m.Ambient = 1
+	s.SetMaterial(m)
+	if !s.Material().IsEqualTo(m) {
+		t.Fatalf("Expected sphere material to be %s, but got %s", m, s.Material())
+	}
+}
+
+// TestSphereIntersect casts rays at spheres and checks
+// if the intersections are computed correctly
+func TestSphereIntersect(t *testing.T) {
+	testCases := []struct {
+		name      string
+		r         rays.Ray
+		transform matrices.Transformation
+		expTs     []float64
+	}{
+		{"two points", rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), nil, []float64{4, 6}},
+		{"tangent", rays.NewRay(tuples.NewPoint(0, 1, -5), tuples.NewVector(0, 0, 1)), nil, []float64{5, 5}},
+		{"miss", rays.NewRay(tuples.NewPoint(0, 2, -5), tuples.NewVector(0, 0, 1)), nil, []float64{}},
+		{"ray inside sphere", rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1)), nil, []float64{-1, 1}},
+		{"sphere behind ray", rays.NewRay(tuples.NewPoint(0, 0, 5), tuples.NewVector(0, 0, 1)), nil, []float64{-6, -4}},
+		{"scaled sphere", rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), matrices.NewScaling(2, 2, 2), []float64{3, 7}},
+		{"translated sphere", rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), matrices.NewTranslation(5, 0, 0), []float64{}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := NewSphere()
+			if testCase.transform != nil {
+				s.SetTransform(testCase.transform)
+			}
+			xs := Intersect(s, testCase.r)
+			if len(xs) != len(testCase.expTs) {
+				t.Fatalf("Expected %d intersections, but got %d: %s", len(testCase.expTs), len(xs), xs)
+			}
+			for i, expT := range testCase.expTs {
+				if !utils.FloatEqual(xs[i].T, expT) {
+					t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+				}
+				if xs[i].Object != s {
+					t.Fatalf("Expected intersection %d to be with the intersected sphere", i)
+				}
+			}
+		})
+	}
+}
+
+// TestSphereNormalAt checks if surface normals of spheres
+// are computed correctly, including under transformations
+func TestSphereNormalAt(t *testing.T) {
+	k := math.Sqrt(3) / 3
+	testCases := []struct {
+		name      string
+		point     tuples.Point
+		transform matrices.Transformation
+		expNormal tuples.Vector
+	}{
+		{"on x axis", tuples.NewPoint(1, 0, 0), nil, tuples.NewVector(1, 0, 0)},
+		{"on y axis", tuples.NewPoint(0, 1, 0), nil, tuples.NewVector(0, 1, 0)},
+		{"on z axis", tuples.NewPoint(0, 0, 1), nil, tuples.NewVector(0, 0, 1)},
+		{"non-axial point", tuples.NewPoint(k, k, k), nil, tuples.NewVector(k, k, k)},
+		{"translated sphere", tuples.NewPoint(0, 1.70711, -0.70711), matrices.NewTranslation(0, 1, 0), tuples.NewVector(0, 0.707107, -0.707107)},
+		{"non-uniformly scaled sphere", tuples.NewPoint(0, math.Sqrt(2)/2, -math.Sqrt(2)/2), matrices.NewScaling(1, 0.5, 1), tuples.NewVector(0, 4/math.Sqrt(17), -1/math.Sqrt(17))},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := NewSphere()
+			if testCase.transform != nil {
+				s.SetTransform(testCase.transform)
+			}
+			normal := NormalAt(s, testCase.point, Intersection{})
+			if !normal.IsEqualTo(testCase.expNormal) {
+				t.Fatalf("Expected normal at %s to be %s, but got %s", testCase.point, testCase.expNormal, normal)
+			}
+			if !utils.FloatEqual(normal.Magnitude(), 1) {
+				t.Fatalf("Expected normal %s to be normalized", normal)
+			}
+		})
+	}
+
+	t.Run("normal stays perpendicular under non-uniform scaling", func(t *testing.T) {
+		s := NewSphere()
+		s.SetTransform(matrices.NewScaling(4, 0.25, 1))
+		// point on the unit sphere, and a tangent to the sphere at that point,
+		// both moved into world space
+		k := math.Sqrt(2) / 2
+		point := matrices.Transform(tuples.NewPoint(0, k, k), s.Transform())
+		tangent := matrices.Transform(tuples.NewVector(0, k, -k), s.Transform())
+		normal := NormalAt(s, point, Intersection{})
+		if !utils.FloatEqual(normal.Dot(tangent), 0) {
+			t.Fatalf("Expected normal %s to be perpendicular to surface tangent %s", normal, tangent)
+		}
+	})
+}
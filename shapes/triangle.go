@@ -0,0 +1,80 @@
+package shapes
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// triangleGeometry holds the vertices of a triangle,
+// along with the two edges leaving its first vertex
+type triangleGeometry struct {
+	p1, p2, p3 tuples.Point
+	e1, e2     tuples.Vector
+}
+
+func newTriangleGeometry(p1, p2, p3 tuples.Point) triangleGeometry {
+	return triangleGeometry{p1: p1, p2: p2, p3: p3, e1: p2.Subtract(p1), e2: p3.Subtract(p1)}
+}
+
+// intersect returns the intersection of object space ray r with
+// triangle g, belonging to shape s, using the Möller–Trumbore algorithm
+//
+// The intersection records the barycentric coordinates u and v of the
+// hit, weighting p2 and p3 respectively. Rays parallel to the triangle
+// never hit it
+func (g *triangleGeometry) intersect(s Shape, r rays.Ray) Intersections {
+	dirCrossE2 := r.Direction.Cross(g.e2)
+	det := g.e1.Dot(dirCrossE2)
+	if math.Abs(det) < utils.FloatDiffThreshold {
+		return Intersections{}
+	}
+	f := 1 / det
+	p1ToOrigin := r.Origin.Subtract(g.p1)
+	u := f * p1ToOrigin.Dot(dirCrossE2)
+	if u < 0 || u > 1 {
+		return Intersections{}
+	}
+	originCrossE1 := p1ToOrigin.Cross(g.e1)
+	v := f * r.Direction.Dot(originCrossE1)
+	if v < 0 || u+v > 1 {
+		return Intersections{}
+	}
+	t := f * g.e2.Dot(originCrossE1)
+	return NewIntersections(NewIntersectionWithUV(t, s, u, v))
+}
+
+type triangle struct {
+	baseShape
+	triangleGeometry
+	normal tuples.Normal
+}
+
+// NewTriangle returns a flat triangle with vertices p1, p2 and p3,
+// with the identity transformation and the default material
+//
+// Its normal faces the side from which the vertices
+// appear in clockwise order
+func NewTriangle(p1, p2, p3 tuples.Point) Shape {
+	g := newTriangleGeometry(p1, p2, p3)
+	normal, _ := g.e2.Cross(g.e1).Normalized()
+	return &triangle{
+		baseShape:        newBaseShape(),
+		triangleGeometry: g,
+		normal:           tuples.NewNormal(normal.X, normal.Y, normal.Z),
+	}
+}
+
+// LocalIntersect returns the intersections of object space ray r
+// with triangle tr
+func (tr *triangle) LocalIntersect(r rays.Ray) Intersections {
+	return tr.intersect(tr, r)
+}
+
+// LocalNormalAt returns the surface normal of triangle tr,
+// which is the same everywhere
+func (tr *triangle) LocalNormalAt(tuples.Point, Intersection) tuples.Normal {
+	return tr.normal
+}
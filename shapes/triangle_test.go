@@ -0,0 +1,68 @@
+package shapes
+
+import (
+	"testing"
+
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// newTestTriangle returns the triangle shared by the triangle tests
+func newTestTriangle() *triangle {
+	return NewTriangle(tuples.NewPoint(0, 1, 0), tuples.NewPoint(-1, 0, 0), tuples.NewPoint(1, 0, 0)).(*triangle)
+}
+
+// TestNewTriangle checks if the edges and the normal
+// of a triangle are precomputed from its vertices
+func TestNewTriangle(t *testing.T) {
+	tr := newTestTriangle()
+	expE1 := tuples.NewVector(-1, -1, 0)
+	expE2 := tuples.NewVector(1, -1, 0)
+	expNormal := tuples.NewNormal(0, 0, -1)
+	if !tr.e1.IsEqualTo(expE1) || !tr.e2.IsEqualTo(expE2) {
+		t.Fatalf("Expected edges to be %s and %s, but got %s and %s", expE1, expE2, tr.e1, tr.e2)
+	}
+	if !tr.normal.IsEqualTo(expNormal) {
+		t.Fatalf("Expected normal to be %s, but got %s", expNormal, tr.normal)
+	}
+	for _, point := range []tuples.Point{tuples.NewPoint(0, 0.5, 0), tuples.NewPoint(-0.5, 0.75, 0), tuples.NewPoint(0.5, 0.25, 0)} {
+		if normal := tr.LocalNormalAt(point, Intersection{}); !normal.IsEqualTo(expNormal) {
+			t.Fatalf("Expected normal at %s to be %s, but got %s", point, expNormal, normal)
+		}
+	}
+}
+
+// TestTriangleIntersect casts rays at a triangle and
+// checks if only rays within its edges hit it
+func TestTriangleIntersect(t *testing.T) {
+	testCases := []struct {
+		name  string
+		r     rays.Ray
+		expTs []float64
+	}{
+		{"parallel ray", rays.NewRay(tuples.NewPoint(0, -1, -2), tuples.NewVector(0, 1, 0)), []float64{}},
+		{"miss the p1-p3 edge", rays.NewRay(tuples.NewPoint(1, 1, -2), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"miss the p1-p2 edge", rays.NewRay(tuples.NewPoint(-1, 1, -2), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"miss the p2-p3 edge", rays.NewRay(tuples.NewPoint(0, -1, -2), tuples.NewVector(0, 0, 1)), []float64{}},
+		{"hit", rays.NewRay(tuples.NewPoint(0, 0.5, -2), tuples.NewVector(0, 0, 1)), []float64{2}},
+		{"hit from behind", rays.NewRay(tuples.NewPoint(0, 0.5, 2), tuples.NewVector(0, 0, -1)), []float64{2}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			tr := newTestTriangle()
+			xs := Intersect(tr, testCase.r)
+			if len(xs) != len(testCase.expTs) {
+				t.Fatalf("Expected %d intersections, but got %d: %s", len(testCase.expTs), len(xs), xs)
+			}
+			for i, expT := range testCase.expTs {
+				if !utils.FloatEqual(xs[i].T, expT) {
+					t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+				}
+				if xs[i].Object != Shape(tr) {
+					t.Fatalf("Expected intersection %d to be with the intersected triangle", i)
+				}
+			}
+		})
+	}
+}
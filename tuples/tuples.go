@@ -11,7 +11,7 @@ import (
 )
 
 type Tuple interface {
-	Point | Vector
+	Point | Vector | Normal
 }
 
 type Point struct {
@@ -22,6 +22,14 @@ type Vector struct {
 	X, Y, Z float64
 }
 
+// Normal is a direction perpendicular to a surface
+//
+// It is kept apart from Vector because transformations
+// move normals differently from other directions
+type Normal struct {
+	X, Y, Z float64
+}
+
 // NewPoint returns a new Point with the given coordinates
 func NewPoint(x, y, z float64) Point {
 	return Point{X: x, Y: y, Z: z}
@@ -32,6 +40,11 @@ func NewVector(x, y, z float64) Vector {
 	return Vector{X: x, Y: y, Z: z}
 }
 
+// NewNormal returns a new Normal with the given components
+func NewNormal(x, y, z float64) Normal {
+	return Normal{X: x, Y: y, Z: z}
+}
+
 // String returns the string representation of p
 func (p Point) String() string {
 	formatString := "(%.3f,%.3f,%.3f)"
@@ -129,3 +142,43 @@ func (v1 Vector) Dot(v2 Vector) float64 {
 func (v1 Vector) Cross(v2 Vector) Vector {
 	return NewVector(v1.Y*v2.Z-v1.Z*v2.Y, v2.X*v1.Z-v2.Z*v1.X, v1.X*v2.Y-v1.Y*v2.X)
 }
+
+// Reflect returns the vector v reflected around the given normal
+func (v Vector) Reflect(normal Vector) Vector {
+	return v.Subtract(normal.Multiply(2 * v.Dot(normal)))
+}
+
+// String returns the string representation of n
+func (n Normal) String() string {
+	formatString := "n<%.3f,%.3f,%.3f>"
+	return fmt.Sprintf(formatString, n.X, n.Y, n.Z)
+}
+
+// IsEqualTo reports whether n1 and n2 are equal
+// by performing element-wise float comparison
+func (n1 Normal) IsEqualTo(n2 Normal) bool {
+	return utils.FloatEqual(n1.X, n2.X) &&
+		utils.FloatEqual(n1.Y, n2.Y) &&
+		utils.FloatEqual(n1.Z, n2.Z)
+}
+
+// Vector returns the direction of n as a Vector
+func (n Normal) Vector() Vector {
+	return NewVector(n.X, n.Y, n.Z)
+}
+
+// Negated returns the normal pointing opposite to n
+func (n Normal) Negated() Normal {
+	return NewNormal(-n.X, -n.Y, -n.Z)
+}
+
+// Normalized returns the unit length version of n
+//
+// Returns an error if n has zero length
+func (n Normal) Normalized() (Normal, error) {
+	v, err := n.Vector().Normalized()
+	if err != nil {
+		return Normal{}, err
+	}
+	return NewNormal(v.X, v.Y, v.Z), nil
+}
@@ -380,3 +380,73 @@ func TestCrossProduct(t *testing.T) {
 		})
 	}
 }
+
+// TestVectorReflect creates vectors and
+// checks if their reflections around normals are calculated correctly
+func TestVectorReflect(t *testing.T) {
+	var testCases = []struct {
+		name       string
+		v          Vector
+		normal     Vector
+		expReflect Vector
+	}{
+		{"approaching at 45 degrees", NewVector(1, -1, 0), NewVector(0, 1, 0), NewVector(1, 1, 0)},
+		{"slanted surface", NewVector(0, -1, 0), NewVector(math.Sqrt(2)/2, math.Sqrt(2)/2, 0), NewVector(1, 0, 0)},
+		{"head on", NewVector(0, 0, -2), NewVector(0, 0, 1), NewVector(0, 0, 2)},
+		{"parallel to surface", NewVector(3, 0, 0), NewVector(0, 1, 0), NewVector(3, 0, 0)},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			reflect := testCase.v.Reflect(testCase.normal)
+			if !testCase.expReflect.IsEqualTo(reflect) {
+				t.Fatalf("Expected %s reflected around %s to be %s, but got %s", testCase.v, testCase.normal, testCase.expReflect, reflect)
+			}
+		})
+	}
+}
+
+// TestNormal creates normals and checks if they can be
+// compared, negated, normalized and converted to vectors
+func TestNormal(t *testing.T) {
+	n := NewNormal(0, 3, -4)
+	t.Run("string representation", func(t *testing.T) {
+		expS := "n<0.000,3.000,-4.000>"
+		if n.String() != expS {
+			t.Fatalf("Expected %s, but got %s", expS, n)
+		}
+	})
+	t.Run("equality", func(t *testing.T) {
+		if !n.IsEqualTo(NewNormal(0, 3.0000000001, -4)) {
+			t.Fatalf("Expected %s to be equal to %s", n, NewNormal(0, 3.0000000001, -4))
+		}
+		if n.IsEqualTo(NewNormal(0, 3, 4)) {
+			t.Fatalf("Not expected %s to be equal to %s", n, NewNormal(0, 3, 4))
+		}
+	})
+	t.Run("negation", func(t *testing.T) {
+		expN := NewNormal(0, -3, 4)
+		if !n.Negated().IsEqualTo(expN) {
+			t.Fatalf("Expected %s, but got %s", expN, n.Negated())
+		}
+	})
+	t.Run("conversion to vector", func(t *testing.T) {
+		expV := NewVector(0, 3, -4)
+		if !n.Vector().IsEqualTo(expV) {
+			t.Fatalf("Expected %s, but got %s", expV, n.Vector())
+		}
+	})
+	t.Run("normalization", func(t *testing.T) {
+		expN := NewNormal(0, 0.6, -0.8)
+		nNorm, err := n.Normalized()
+		if err != nil {
+			t.Fatalf("No error expected, but got: %q", err)
+		}
+		if !nNorm.IsEqualTo(expN) {
+			t.Fatalf("Expected %s, but got %s", expN, nNorm)
+		}
+		if _, err := NewNormal(0, 0, 0).Normalized(); err == nil {
+			t.Fatalf("Error expected normalizing a zero normal, but received none")
+		}
+	})
+}
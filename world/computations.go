@@ -0,0 +1,117 @@
+package world
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type Computations struct {
+	T          float64
+	Object     shapes.Shape
+	Point      tuples.Point
+	OverPoint  tuples.Point
+	UnderPoint tuples.Point
+	Eyev       tuples.Vector
+	Normalv    tuples.Vector
+	Reflectv   tuples.Vector
+	Inside     bool
+	N1, N2     float64
+}
+
+// PrepareComputations precomputes the values needed
+// to shade the intersection hit along ray r
+//
+// If the hit occurs from inside the object, the normal is inverted
+// so that it points towards the eye. Reflectv is the direction
+// of the ray bouncing off the surface. OverPoint and UnderPoint are
+// the hit point nudged slightly above and below the surface, so that
+// reflected and refracted rays cast from them don't intersect the
+// surface they start on.
+//
+// N1 and N2 are the refractive indices of the materials the ray
+// leaves and enters at the hit. They are found by walking xs, every
+// intersection along r, and keeping track of the objects containing
+// the ray. If xs is empty, hit is assumed to be the only intersection
+func PrepareComputations(hit shapes.Intersection, r rays.Ray, xs ...shapes.Intersection) Computations {
+	comps := Computations{
+		T:      hit.T,
+		Object: hit.Object,
+		Point:  r.Position(hit.T),
+		Eyev:   r.Direction.Negated(),
+	}
+	comps.Normalv = shapes.NormalAt(comps.Object, comps.Point, hit)
+	if comps.Normalv.Dot(comps.Eyev) < 0 {
+		comps.Inside = true
+		comps.Normalv = comps.Normalv.Negated()
+	}
+	comps.Reflectv = r.Direction.Reflect(comps.Normalv)
+	comps.OverPoint = comps.Point.Move(comps.Normalv.Multiply(OverPointOffset))
+	comps.UnderPoint = comps.Point.MoveBack(comps.Normalv.Multiply(OverPointOffset))
+	if len(xs) == 0 {
+		xs = []shapes.Intersection{hit}
+	}
+	comps.N1, comps.N2 = refractiveIndices(hit, xs)
+	return comps
+}
+
+// refractiveIndices returns the refractive indices of the materials
+// on either side of the surface at hit, given every intersection xs
+// along the same ray sorted in increasing order of t
+func refractiveIndices(hit shapes.Intersection, xs []shapes.Intersection) (n1, n2 float64) {
+	n1, n2 = materials.RefractiveIndexVacuum, materials.RefractiveIndexVacuum
+	containers := []shapes.Shape{}
+	// current returns the refractive index of the
+	// innermost object the ray is currently in
+	current := func() float64 {
+		if len(containers) == 0 {
+			return materials.RefractiveIndexVacuum
+		}
+		return containers[len(containers)-1].Material().RefractiveIndex
+	}
+	for _, x := range xs {
+		if x == hit {
+			n1 = current()
+		}
+		// the ray either enters or leaves x.Object here
+		left := false
+		for i, object := range containers {
+			if object == x.Object {
+				containers = append(containers[:i], containers[i+1:]...)
+				left = true
+				break
+			}
+		}
+		if !left {
+			containers = append(containers, x.Object)
+		}
+		if x == hit {
+			n2 = current()
+			break
+		}
+	}
+	return n1, n2
+}
+
+// Schlick returns the fraction of light reflected at the
+// intersection described by comps, approximating the
+// Fresnel effect with Schlick's formula
+//
+// It is 1 under total internal reflection
+func (comps Computations) Schlick() float64 {
+	cos := comps.Eyev.Dot(comps.Normalv)
+	if comps.N1 > comps.N2 {
+		nRatio := comps.N1 / comps.N2
+		sin2T := nRatio * nRatio * (1 - cos*cos)
+		if sin2T > 1 {
+			return 1
+		}
+		// use the angle of the transmitted ray when leaving a denser material
+		cos = math.Sqrt(1 - sin2T)
+	}
+	r0 := math.Pow((comps.N1-comps.N2)/(comps.N1+comps.N2), 2)
+	return r0 + (1-r0)*math.Pow(1-cos, 5)
+}
@@ -0,0 +1,205 @@
+package world
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestPrepareComputations checks if the state of an
+// intersection is precomputed correctly
+func TestPrepareComputations(t *testing.T) {
+	testCases := []struct {
+		name       string
+		r          rays.Ray
+		t          float64
+		expPoint   tuples.Point
+		expEyev    tuples.Vector
+		expNormalv tuples.Vector
+		expInside  bool
+	}{
+		{
+			"hit from outside",
+			rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), 4,
+			tuples.NewPoint(0, 0, -1), tuples.NewVector(0, 0, -1), tuples.NewVector(0, 0, -1), false,
+		},
+		{
+			"hit from inside",
+			rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1)), 1,
+			tuples.NewPoint(0, 0, 1), tuples.NewVector(0, 0, -1), tuples.NewVector(0, 0, -1), true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := shapes.NewSphere()
+			comps := PrepareComputations(shapes.NewIntersection(testCase.t, s), testCase.r)
+			if comps.T != testCase.t || comps.Object != s {
+				t.Fatalf("Expected computations to keep the intersection's t and object")
+			}
+			if !comps.Point.IsEqualTo(testCase.expPoint) {
+				t.Fatalf("Expected point to be %s, but got %s", testCase.expPoint, comps.Point)
+			}
+			if !comps.Eyev.IsEqualTo(testCase.expEyev) {
+				t.Fatalf("Expected eye vector to be %s, but got %s", testCase.expEyev, comps.Eyev)
+			}
+			if !comps.Normalv.IsEqualTo(testCase.expNormalv) {
+				t.Fatalf("Expected normal to be %s, but got %s", testCase.expNormalv, comps.Normalv)
+			}
+			if comps.Inside != testCase.expInside {
+				t.Fatalf("Expected inside to be %t, but got %t", testCase.expInside, comps.Inside)
+			}
+		})
+	}
+}
+
+// TestPrepareComputationsReflectv checks if the reflection
+// vector bounces off the surface around the normal
+func TestPrepareComputationsReflectv(t *testing.T) {
+	k := math.Sqrt2 / 2
+	r := rays.NewRay(tuples.NewPoint(0, 1, -1), tuples.NewVector(0, -k, k))
+	comps := PrepareComputations(shapes.NewIntersection(math.Sqrt2, shapes.NewPlane()), r)
+	expReflectv := tuples.NewVector(0, k, k)
+	if !comps.Reflectv.IsEqualTo(expReflectv) {
+		t.Fatalf("Expected reflection vector to be %s, but got %s", expReflectv, comps.Reflectv)
+	}
+}
+
+// TestPrepareComputationsOverPoint checks if the over point
+// is offset slightly above the surface along the normal
+func TestPrepareComputationsOverPoint(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := shapes.NewSphere()
+	s.SetTransform(matrices.NewTranslation(0, 0, 1))
+	comps := PrepareComputations(shapes.NewIntersection(5, s), r)
+	if comps.OverPoint.Z >= -OverPointOffset/2 {
+		t.Fatalf("Expected over point %s to be above the surface", comps.OverPoint)
+	}
+	if comps.Point.Z <= comps.OverPoint.Z {
+		t.Fatalf("Expected point %s to be below over point %s", comps.Point, comps.OverPoint)
+	}
+	if !utils.FloatEqual(comps.Point.Subtract(comps.OverPoint).Magnitude(), OverPointOffset) {
+		t.Fatalf("Expected over point to be %f away from the point", OverPointOffset)
+	}
+}
+
+// glassSphere returns a sphere made of glass
+func glassSphere() shapes.Shape {
+	s := shapes.NewSphere()
+	s.SetMaterial(materials.NewGlassMaterial())
+	return s
+}
+
+// TestPrepareComputationsUnderPoint checks if the under point
+// is offset slightly below the surface along the normal
+func TestPrepareComputationsUnderPoint(t *testing.T) {
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	s := glassSphere()
+	s.SetTransform(matrices.NewTranslation(0, 0, 1))
+	hit := shapes.NewIntersection(5, s)
+	comps := PrepareComputations(hit, r, hit)
+	if comps.UnderPoint.Z <= OverPointOffset/2 {
+		t.Fatalf("Expected under point %s to be below the surface", comps.UnderPoint)
+	}
+	if comps.Point.Z >= comps.UnderPoint.Z {
+		t.Fatalf("Expected point %s to be above under point %s", comps.Point, comps.UnderPoint)
+	}
+}
+
+// TestPrepareComputationsRefractiveIndices checks if the refractive
+// indices on either side of every intersection are found by tracking
+// the objects containing the ray
+func TestPrepareComputationsRefractiveIndices(t *testing.T) {
+	a := glassSphere()
+	a.SetTransform(matrices.NewScaling(2, 2, 2))
+	b := glassSphere()
+	b.SetTransform(matrices.NewTranslation(0, 0, -0.25))
+	c := glassSphere()
+	c.SetTransform(matrices.NewTranslation(0, 0, 0.25))
+	for object, index := range map[shapes.Shape]float64{a: 1.5, b: 2, c: 2.5} {
+		m := object.Material()
+		m.RefractiveIndex = index
+		object.SetMaterial(m)
+	}
+	r := rays.NewRay(tuples.NewPoint(0, 0, -4), tuples.NewVector(0, 0, 1))
+	xs := shapes.NewIntersections(
+		shapes.NewIntersection(2, a),
+		shapes.NewIntersection(2.75, b),
+		shapes.NewIntersection(3.25, c),
+		shapes.NewIntersection(4.75, b),
+		shapes.NewIntersection(5.25, c),
+		shapes.NewIntersection(6, a),
+	)
+	testCases := []struct {
+		name  string
+		index int
+		expN1 float64
+		expN2 float64
+	}{
+		{"entering a", 0, 1, 1.5},
+		{"entering b", 1, 1.5, 2},
+		{"entering c", 2, 2, 2.5},
+		{"leaving b", 3, 2.5, 2.5},
+		{"leaving c", 4, 2.5, 1.5},
+		{"leaving a", 5, 1.5, 1},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			comps := PrepareComputations(xs[testCase.index], r, xs...)
+			if comps.N1 != testCase.expN1 || comps.N2 != testCase.expN2 {
+				t.Fatalf("Expected n1,n2 to be %.2f,%.2f but got %.2f,%.2f", testCase.expN1, testCase.expN2, comps.N1, comps.N2)
+			}
+		})
+	}
+}
+
+// TestSchlick checks the reflectance of glass
+// at various angles of incidence
+func TestSchlick(t *testing.T) {
+	k := math.Sqrt2 / 2
+	testCases := []struct {
+		name           string
+		r              rays.Ray
+		ts             []float64
+		hitIndex       int
+		expReflectance float64
+	}{
+		{"total internal reflection", rays.NewRay(tuples.NewPoint(0, 0, k), tuples.NewVector(0, 1, 0)), []float64{-k, k}, 1, 1},
+		{"perpendicular ray", rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0)), []float64{-1, 1}, 1, 0.04},
+		{"small angle", rays.NewRay(tuples.NewPoint(0, 0.99, -2), tuples.NewVector(0, 0, 1)), []float64{2 - math.Sqrt(1-0.99*0.99)}, 0, 0.04 + 0.96*math.Pow(1-math.Sqrt(1-0.99*0.99), 5)},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s := glassSphere()
+			xs := shapes.Intersections{}
+			for _, x := range testCase.ts {
+				xs = append(xs, shapes.NewIntersection(x, s))
+			}
+			comps := PrepareComputations(xs[testCase.hitIndex], testCase.r, xs...)
+			if reflectance := comps.Schlick(); !utils.FloatEqual(reflectance, testCase.expReflectance) {
+				t.Fatalf("Expected reflectance to be %f but got %f", testCase.expReflectance, reflectance)
+			}
+		})
+	}
+}
+
+// TestPrepareComputationsSmoothTriangle checks if the hit is used
+// to interpolate the normal of smooth triangles
+func TestPrepareComputationsSmoothTriangle(t *testing.T) {
+	tr := shapes.NewSmoothTriangle(
+		tuples.NewPoint(0, 1, 0), tuples.NewPoint(-1, 0, 0), tuples.NewPoint(1, 0, 0),
+		tuples.NewNormal(0, 1, 0), tuples.NewNormal(-1, 0, 0), tuples.NewNormal(1, 0, 0),
+	)
+	r := rays.NewRay(tuples.NewPoint(-0.2, 0.3, -2), tuples.NewVector(0, 0, 1))
+	hit := shapes.NewIntersectionWithUV(1, tr, 0.45, 0.25)
+	comps := PrepareComputations(hit, r, hit)
+	expNormalv := tuples.NewVector(-2/math.Sqrt(13), 3/math.Sqrt(13), 0)
+	if !comps.Normalv.IsEqualTo(expNormalv) {
+		t.Fatalf("Expected normal to be %s, but got %s", expNormalv, comps.Normalv)
+	}
+}
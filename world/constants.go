@@ -0,0 +1,11 @@
+package world
+
+import "github.com/schapagain/raytracer/utils"
+
+// OverPointOffset is the distance by which hit points are
+// moved along or against the surface normal to avoid self-intersection
+const OverPointOffset = 10 * utils.FloatDiffThreshold
+
+// DefaultMaxDepth is the number of times a ray is allowed
+// to bounce off reflective surfaces when rendering
+const DefaultMaxDepth = 5
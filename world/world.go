@@ -0,0 +1,162 @@
+// package world provides the World type holding every
+// object and light in a scene, and the shading pipeline
+// used to compute the color seen along a ray
+package world
+
+import (
+	"math"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/lights"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+)
+
+type World struct {
+	Objects []shapes.Shape
+	Lights  []lights.PointLight
+}
+
+// NewWorld returns an empty world with no objects or lights
+func NewWorld() *World {
+	return &World{}
+}
+
+// DefaultWorld returns a world with a single white light
+// and two concentric spheres, used as a common scene in tests
+func DefaultWorld() *World {
+	outer := shapes.NewSphere()
+	m := materials.NewMaterial()
+	m.Color = canvas.Color{R: 0.8, G: 1.0, B: 0.6}
+	m.Diffuse = 0.7
+	m.Specular = 0.2
+	outer.SetMaterial(m)
+
+	inner := shapes.NewSphere()
+	inner.SetTransform(matrices.NewScaling(0.5, 0.5, 0.5))
+
+	return &World{
+		Objects: []shapes.Shape{outer, inner},
+		Lights: []lights.PointLight{
+			lights.NewPointLight(tuples.NewPoint(-10, 10, -10), canvas.Color{R: 1, G: 1, B: 1}),
+		},
+	}
+}
+
+// AddObject adds the given objects to world w
+func (w *World) AddObject(objects ...shapes.Shape) {
+	w.Objects = append(w.Objects, objects...)
+}
+
+// AddLight adds the given lights to world w
+func (w *World) AddLight(pointLights ...lights.PointLight) {
+	w.Lights = append(w.Lights, pointLights...)
+}
+
+// IntersectWorld returns the intersections of ray r with
+// every object in world w, sorted in increasing order of t
+func (w *World) IntersectWorld(r rays.Ray) shapes.Intersections {
+	xs := shapes.Intersections{}
+	for _, object := range w.Objects {
+		xs = append(xs, shapes.Intersect(object, r)...)
+	}
+	xs.Sort()
+	return xs
+}
+
+// IsShadowed reports whether point is hidden from light
+// by any object in world w
+//
+// A shadow ray is cast from point towards the light, and any hit
+// closer than the light itself blocks it. The point is expected
+// to be offset from the surface already, such as Computations.OverPoint
+func (w *World) IsShadowed(point tuples.Point, light lights.PointLight) bool {
+	v := light.Position.Subtract(point)
+	distance := v.Magnitude()
+	direction, err := v.Normalized()
+	if err != nil {
+		return false
+	}
+	hit, ok := w.IntersectWorld(rays.NewRay(point, direction)).Hit()
+	return ok && hit.T < distance
+}
+
+// ShadeHit returns the color at the intersection described by comps,
+// summing the contribution of every light in world w, and the
+// reflection and refraction of the world, which may bounce remaining
+// more times
+//
+// Lighting and shadows are evaluated at comps.OverPoint
+// to avoid the surface shadowing itself. Surfaces that are both
+// reflective and transparent blend the reflection and refraction
+// using comps.Schlick
+func (w *World) ShadeHit(comps Computations, remaining int) canvas.Color {
+	color := canvas.Color{}
+	for _, light := range w.Lights {
+		inShadow := w.IsShadowed(comps.OverPoint, light)
+		color = color.Add(lights.Lighting(comps.Object.Material(), comps.Object, light, comps.OverPoint, comps.Eyev, comps.Normalv, inShadow))
+	}
+	reflected := w.ReflectedColor(comps, remaining)
+	refracted := w.RefractedColor(comps, remaining)
+	m := comps.Object.Material()
+	if m.Reflective > 0 && m.Transparency > 0 {
+		reflectance := comps.Schlick()
+		return color.Add(reflected.Scale(reflectance)).Add(refracted.Scale(1 - reflectance))
+	}
+	return color.Add(reflected).Add(refracted)
+}
+
+// ReflectedColor returns the color reflected by the surface at the
+// intersection described by comps, weighted by its reflectiveness
+//
+// A ray is cast from comps.OverPoint along comps.Reflectv, which
+// may bounce remaining more times. Non-reflective surfaces and
+// rays with no bounces left reflect black
+func (w *World) ReflectedColor(comps Computations, remaining int) canvas.Color {
+	reflective := comps.Object.Material().Reflective
+	if remaining < 1 || reflective == 0 {
+		return canvas.Color{}
+	}
+	reflectedRay := rays.NewRay(comps.OverPoint, comps.Reflectv)
+	return w.ColorAt(reflectedRay, remaining-1).Scale(reflective)
+}
+
+// RefractedColor returns the color seen through the surface at the
+// intersection described by comps, weighted by its transparency
+//
+// A ray bent according to Snell's law is cast from comps.UnderPoint,
+// which may bounce remaining more times. Opaque surfaces, rays with
+// no bounces left and total internal reflection give black
+func (w *World) RefractedColor(comps Computations, remaining int) canvas.Color {
+	transparency := comps.Object.Material().Transparency
+	if remaining < 1 || transparency == 0 {
+		return canvas.Color{}
+	}
+	nRatio := comps.N1 / comps.N2
+	cosI := comps.Eyev.Dot(comps.Normalv)
+	sin2T := nRatio * nRatio * (1 - cosI*cosI)
+	if sin2T > 1 {
+		return canvas.Color{}
+	}
+	cosT := math.Sqrt(1 - sin2T)
+	direction := comps.Normalv.Multiply(nRatio*cosI - cosT).Subtract(comps.Eyev.Multiply(nRatio))
+	refractedRay := rays.NewRay(comps.UnderPoint, direction)
+	return w.ColorAt(refractedRay, remaining-1).Scale(transparency)
+}
+
+// ColorAt returns the color seen along ray r in world w,
+// which may bounce off reflective and through transparent
+// surfaces remaining times
+//
+// It returns black if the ray doesn't hit any object
+func (w *World) ColorAt(r rays.Ray, remaining int) canvas.Color {
+	xs := w.IntersectWorld(r)
+	hit, ok := xs.Hit()
+	if !ok {
+		return canvas.Color{}
+	}
+	return w.ShadeHit(PrepareComputations(hit, r, xs...), remaining)
+}
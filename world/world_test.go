@@ -0,0 +1,354 @@
+package world
+
+import (
+	"math"
+	"testing"
+
+	"github.com/schapagain/raytracer/canvas"
+	"github.com/schapagain/raytracer/lights"
+	"github.com/schapagain/raytracer/materials"
+	"github.com/schapagain/raytracer/matrices"
+	"github.com/schapagain/raytracer/rays"
+	"github.com/schapagain/raytracer/shapes"
+	"github.com/schapagain/raytracer/tuples"
+	"github.com/schapagain/raytracer/utils"
+)
+
+// TestNewWorld checks if a new world is empty
+func TestNewWorld(t *testing.T) {
+	w := NewWorld()
+	if len(w.Objects) != 0 {
+		t.Fatalf("Expected new world to have no objects, but got %d", len(w.Objects))
+	}
+	if len(w.Lights) != 0 {
+		t.Fatalf("Expected new world to have no lights, but got %d", len(w.Lights))
+	}
+	w.AddObject(shapes.NewSphere(), shapes.NewSphere())
+	w.AddLight(lights.NewPointLight(tuples.NewPoint(0, 0, 0), canvas.Color{R: 1, G: 1, B: 1}))
+	if len(w.Objects) != 2 || len(w.Lights) != 1 {
+		t.Fatalf("Expected world to have 2 objects and 1 light, but got %d and %d", len(w.Objects), len(w.Lights))
+	}
+}
+
+// TestDefaultWorld checks if the default world
+// contains the expected light and spheres
+func TestDefaultWorld(t *testing.T) {
+	w := DefaultWorld()
+	if len(w.Lights) != 1 {
+		t.Fatalf("Expected default world to have 1 light, but got %d", len(w.Lights))
+	}
+	expLightPos := tuples.NewPoint(-10, 10, -10)
+	if !w.Lights[0].Position.IsEqualTo(expLightPos) {
+		t.Fatalf("Expected default light at %s, but got %s", expLightPos, w.Lights[0].Position)
+	}
+	if len(w.Objects) != 2 {
+		t.Fatalf("Expected default world to have 2 objects, but got %d", len(w.Objects))
+	}
+	expColor := canvas.Color{R: 0.8, G: 1.0, B: 0.6}
+	if !w.Objects[0].Material().Color.IsEqualTo(expColor) {
+		t.Fatalf("Expected outer sphere color to be %s, but got %s", expColor, w.Objects[0].Material().Color)
+	}
+	expTransform := matrices.NewScaling(0.5, 0.5, 0.5)
+	if !w.Objects[1].Transform().Operator().IsEqualTo(expTransform.Operator()) {
+		t.Fatalf("Expected inner sphere transform to be\n%s\nbut got\n%s", expTransform, w.Objects[1].Transform())
+	}
+}
+
+// TestIntersectWorld checks if a ray is intersected with
+// every object in the world, and the results are sorted
+func TestIntersectWorld(t *testing.T) {
+	w := DefaultWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	xs := w.IntersectWorld(r)
+	expTs := []float64{4, 4.5, 5.5, 6}
+	if len(xs) != len(expTs) {
+		t.Fatalf("Expected %d intersections, but got %d: %s", len(expTs), len(xs), xs)
+	}
+	for i, expT := range expTs {
+		if !utils.FloatEqual(xs[i].T, expT) {
+			t.Fatalf("Expected intersection %d to have t=%f, but got %f", i, expT, xs[i].T)
+		}
+	}
+}
+
+// TestShadeHit checks if intersections are shaded correctly
+// when hit from outside and inside of an object
+func TestShadeHit(t *testing.T) {
+	t.Run("from outside", func(t *testing.T) {
+		w := DefaultWorld()
+		r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+		comps := PrepareComputations(shapes.NewIntersection(4, w.Objects[0]), r)
+		// cosine between the light vector and the normal at (0,0,-1)
+		k := 0.1 + 0.7*9/math.Sqrt(281)
+		expColor := canvas.Color{R: 0.8 * k, G: k, B: 0.6 * k}
+		color := w.ShadeHit(comps, DefaultMaxDepth)
+		if !color.IsEqualTo(expColor) {
+			t.Fatalf("Expected shaded color to be %s, but got %s", expColor, color)
+		}
+	})
+	t.Run("from inside", func(t *testing.T) {
+		w := DefaultWorld()
+		w.Lights = []lights.PointLight{lights.NewPointLight(tuples.NewPoint(0, 0.25, 0), canvas.Color{R: 1, G: 1, B: 1})}
+		r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+		comps := PrepareComputations(shapes.NewIntersection(0.5, w.Objects[1]), r)
+		// shading happens at the over point, nudged towards the center
+		d := 0.5 - OverPointOffset
+		k := 0.1 + 0.9*d/math.Sqrt(0.25*0.25+d*d)
+		expColor := canvas.Color{R: k, G: k, B: k}
+		color := w.ShadeHit(comps, DefaultMaxDepth)
+		if !color.IsEqualTo(expColor) {
+			t.Fatalf("Expected shaded color to be %s, but got %s", expColor, color)
+		}
+	})
+	t.Run("multiple lights", func(t *testing.T) {
+		w := DefaultWorld()
+		w.AddLight(w.Lights[0])
+		r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+		comps := PrepareComputations(shapes.NewIntersection(4, w.Objects[0]), r)
+		k := 2 * (0.1 + 0.7*9/math.Sqrt(281))
+		expColor := canvas.Color{R: 0.8 * k, G: k, B: 0.6 * k}
+		color := w.ShadeHit(comps, DefaultMaxDepth)
+		if !color.IsEqualTo(expColor) {
+			t.Fatalf("Expected shaded color to be %s, but got %s", expColor, color)
+		}
+	})
+}
+
+// TestIsShadowed checks if points are in shadow only when
+// an object lies between them and the light
+func TestIsShadowed(t *testing.T) {
+	testCases := []struct {
+		name        string
+		point       tuples.Point
+		expShadowed bool
+	}{
+		{"nothing collinear with point and light", tuples.NewPoint(0, 10, 0), false},
+		{"object between point and light", tuples.NewPoint(10, -10, 10), true},
+		{"object behind the light", tuples.NewPoint(-20, 20, -20), false},
+		{"object behind the point", tuples.NewPoint(-2, 2, -2), false},
+	}
+	w := DefaultWorld()
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			if shadowed := w.IsShadowed(testCase.point, w.Lights[0]); shadowed != testCase.expShadowed {
+				t.Fatalf("Expected shadowed to be %v but got %v", testCase.expShadowed, shadowed)
+			}
+		})
+	}
+}
+
+// TestShadeHitInShadow checks if a hit hidden from the light
+// only receives ambient light
+func TestShadeHitInShadow(t *testing.T) {
+	w := NewWorld()
+	w.AddLight(lights.NewPointLight(tuples.NewPoint(0, 0, -10), canvas.Color{R: 1, G: 1, B: 1}))
+	s2 := shapes.NewSphere()
+	s2.SetTransform(matrices.NewTranslation(0, 0, 10))
+	w.AddObject(shapes.NewSphere(), s2)
+	r := rays.NewRay(tuples.NewPoint(0, 0, 5), tuples.NewVector(0, 0, 1))
+	comps := PrepareComputations(shapes.NewIntersection(4, s2), r)
+	expColor := canvas.Color{R: 0.1, G: 0.1, B: 0.1}
+	if color := w.ShadeHit(comps, DefaultMaxDepth); !color.IsEqualTo(expColor) {
+		t.Fatalf("Expected shaded color to be %s, but got %s", expColor, color)
+	}
+}
+
+// reflectiveFloorWorld returns the default world with a half
+// reflective floor below the spheres, and a ray hitting the floor
+func reflectiveFloorWorld() (*World, Computations) {
+	w := DefaultWorld()
+	floor := shapes.NewPlane()
+	m := floor.Material()
+	m.Reflective = 0.5
+	floor.SetMaterial(m)
+	floor.SetTransform(matrices.NewTranslation(0, -1, 0))
+	w.AddObject(floor)
+	k := math.Sqrt2 / 2
+	r := rays.NewRay(tuples.NewPoint(0, 0, -3), tuples.NewVector(0, -k, k))
+	return w, PrepareComputations(shapes.NewIntersection(math.Sqrt2, floor), r)
+}
+
+// TestReflectedColor checks if reflective surfaces reflect
+// the world within the allowed depth, and others reflect black
+func TestReflectedColor(t *testing.T) {
+	t.Run("non-reflective material", func(t *testing.T) {
+		w := DefaultWorld()
+		r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 0, 1))
+		inner := w.Objects[1]
+		m := inner.Material()
+		m.Ambient = 1
+		inner.SetMaterial(m)
+		comps := PrepareComputations(shapes.NewIntersection(1, inner), r)
+		if color := w.ReflectedColor(comps, DefaultMaxDepth); !color.IsEqualTo(canvas.Color{}) {
+			t.Fatalf("Expected reflected color to be black, but got %s", color)
+		}
+	})
+	t.Run("reflective material", func(t *testing.T) {
+		w, comps := reflectiveFloorWorld()
+		expColor := canvas.Color{R: 0.190332201, G: 0.237915252, B: 0.142749151}
+		if color := w.ReflectedColor(comps, DefaultMaxDepth); !color.IsEqualTo(expColor) {
+			t.Fatalf("Expected reflected color to be %s, but got %s", expColor, color)
+		}
+	})
+	t.Run("no bounces remaining", func(t *testing.T) {
+		w, comps := reflectiveFloorWorld()
+		if color := w.ReflectedColor(comps, 0); !color.IsEqualTo(canvas.Color{}) {
+			t.Fatalf("Expected reflected color to be black, but got %s", color)
+		}
+	})
+	t.Run("shaded with the surface color", func(t *testing.T) {
+		w, comps := reflectiveFloorWorld()
+		expColor := canvas.Color{R: 0.876757284, G: 0.924340334, B: 0.829174233}
+		if color := w.ShadeHit(comps, DefaultMaxDepth); !color.IsEqualTo(expColor) {
+			t.Fatalf("Expected shaded color to be %s, but got %s", expColor, color)
+		}
+	})
+}
+
+// TestRefractedColor checks if opaque surfaces, rays with no bounces
+// left and total internal reflection refract black
+func TestRefractedColor(t *testing.T) {
+	k := math.Sqrt2 / 2
+	testCases := []struct {
+		name      string
+		glass     bool
+		r         rays.Ray
+		ts        []float64
+		hitIndex  int
+		remaining int
+	}{
+		{"opaque surface", false, rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), []float64{4, 6}, 0, DefaultMaxDepth},
+		{"no bounces remaining", true, rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1)), []float64{4, 6}, 0, 0},
+		{"total internal reflection", true, rays.NewRay(tuples.NewPoint(0, 0, k), tuples.NewVector(0, 1, 0)), []float64{-k, k}, 1, DefaultMaxDepth},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := DefaultWorld()
+			outer := w.Objects[0]
+			if testCase.glass {
+				m := outer.Material()
+				m.Transparency = 1
+				m.RefractiveIndex = materials.RefractiveIndexGlass
+				outer.SetMaterial(m)
+			}
+			xs := shapes.Intersections{}
+			for _, x := range testCase.ts {
+				xs = append(xs, shapes.NewIntersection(x, outer))
+			}
+			comps := PrepareComputations(xs[testCase.hitIndex], testCase.r, xs...)
+			if color := w.RefractedColor(comps, testCase.remaining); !color.IsEqualTo(canvas.Color{}) {
+				t.Fatalf("Expected refracted color to be black, but got %s", color)
+			}
+		})
+	}
+}
+
+// TestShadeHitTransparent checks if transparent floors show the
+// objects below them, blended with reflections on reflective ones
+func TestShadeHitTransparent(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reflective float64
+		expColor   canvas.Color
+	}{
+		{"transparent", 0, canvas.Color{R: 0.936425082, G: 0.686425082, B: 0.686425082}},
+		{"transparent and reflective", 0.5, canvas.Color{R: 0.933914901, G: 0.696434004, B: 0.692430435}},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := DefaultWorld()
+			floor := shapes.NewPlane()
+			floor.SetTransform(matrices.NewTranslation(0, -1, 0))
+			floorMaterial := floor.Material()
+			floorMaterial.Transparency = 0.5
+			floorMaterial.RefractiveIndex = materials.RefractiveIndexGlass
+			floorMaterial.Reflective = testCase.reflective
+			floor.SetMaterial(floorMaterial)
+			ball := shapes.NewSphere()
+			ball.SetTransform(matrices.NewTranslation(0, -3.5, -0.5))
+			ballMaterial := ball.Material()
+			ballMaterial.Color = canvas.Color{R: 1, G: 0, B: 0}
+			ballMaterial.Ambient = 0.5
+			ball.SetMaterial(ballMaterial)
+			w.AddObject(floor, ball)
+
+			k := math.Sqrt2 / 2
+			r := rays.NewRay(tuples.NewPoint(0, 0, -3), tuples.NewVector(0, -k, k))
+			xs := w.IntersectWorld(r)
+			hit, _ := xs.Hit()
+			comps := PrepareComputations(hit, r, xs...)
+			if color := w.ShadeHit(comps, DefaultMaxDepth); !color.IsEqualTo(testCase.expColor) {
+				t.Fatalf("Expected shaded color to be %s, but got %s", testCase.expColor, color)
+			}
+		})
+	}
+}
+
+// TestColorAtFacingMirrors checks if rays bouncing between
+// two parallel mirrors stop once they run out of depth
+func TestColorAtFacingMirrors(t *testing.T) {
+	w := NewWorld()
+	w.AddLight(lights.NewPointLight(tuples.NewPoint(0, 0, 0), canvas.Color{R: 1, G: 1, B: 1}))
+	lower := shapes.NewPlane()
+	lower.SetTransform(matrices.NewTranslation(0, -1, 0))
+	upper := shapes.NewPlane()
+	upper.SetTransform(matrices.NewTranslation(0, 1, 0))
+	for _, mirror := range []shapes.Shape{lower, upper} {
+		m := mirror.Material()
+		m.Reflective = 1
+		mirror.SetMaterial(m)
+		w.AddObject(mirror)
+	}
+	r := rays.NewRay(tuples.NewPoint(0, 0, 0), tuples.NewVector(0, 1, 0))
+	shallow := w.ColorAt(r, 1)
+	deep := w.ColorAt(r, 10)
+	if deep.R <= shallow.R {
+		t.Fatalf("Expected more bounces to gather more light, but got %s and %s", shallow, deep)
+	}
+}
+
+// TestColorAt casts rays into the default world and
+// checks if the expected colors are returned
+func TestColorAt(t *testing.T) {
+	t.Run("ray misses", func(t *testing.T) {
+		w := DefaultWorld()
+		r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 1, 0))
+		color := w.ColorAt(r, DefaultMaxDepth)
+		if !color.IsEqualTo(canvas.Color{}) {
+			t.Fatalf("Expected color to be black, but got %s", color)
+		}
+	})
+	t.Run("ray hits", func(t *testing.T) {
+		w := DefaultWorld()
+		r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+		k := 0.1 + 0.7*9/math.Sqrt(281)
+		expColor := canvas.Color{R: 0.8 * k, G: k, B: 0.6 * k}
+		color := w.ColorAt(r, DefaultMaxDepth)
+		if !color.IsEqualTo(expColor) {
+			t.Fatalf("Expected color to be %s, but got %s", expColor, color)
+		}
+	})
+	t.Run("intersection behind the ray", func(t *testing.T) {
+		w := DefaultWorld()
+		for _, object := range w.Objects {
+			m := object.Material()
+			m.Ambient = 1
+			object.SetMaterial(m)
+		}
+		inner := w.Objects[1]
+		r := rays.NewRay(tuples.NewPoint(0, 0, 0.75), tuples.NewVector(0, 0, -1))
+		color := w.ColorAt(r, DefaultMaxDepth)
+		if !color.IsEqualTo(inner.Material().Color) {
+			t.Fatalf("Expected color to be %s, but got %s", inner.Material().Color, color)
+		}
+	})
+}
+
+// TestColorAtEmptyWorld checks if an empty world is black
+func TestColorAtEmptyWorld(t *testing.T) {
+	w := NewWorld()
+	r := rays.NewRay(tuples.NewPoint(0, 0, -5), tuples.NewVector(0, 0, 1))
+	if color := w.ColorAt(r, DefaultMaxDepth); !color.IsEqualTo(canvas.Color{}) {
+		t.Fatalf("Expected color to be black, but got %s", color)
+	}
+}